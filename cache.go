@@ -0,0 +1,243 @@
+package starr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/* This file contains an opt-in caching wrapper for an APIer.
+ * Wrap a Config with NewCache, and pass the result into an app struct, like this:
+ *   app := &radarr.Radarr{APIer: starr.NewCache(starr.New(key, url, 0), 0, starr.DefaultCacheTTLs)}
+ */
+
+// DefaultCacheTTLs is a useful set of TTLs for read-mostly endpoints. Pass it (or a copy) to NewCache.
+//
+//nolint:gochecknoglobals
+var DefaultCacheTTLs = map[string]time.Duration{
+	"tag":             5 * time.Minute,
+	"qualityprofile":  5 * time.Minute,
+	"rootfolder":      time.Minute,
+	"languageprofile": 5 * time.Minute,
+	"metadataprofile": 5 * time.Minute,
+	"system/status":   time.Minute,
+}
+
+// Cache wraps an APIer and caches the responses from GetInto requests.
+// Post, Put and Delete requests made through the Cache invalidate cached responses for the same resource.
+// For example, a PUT to v3/tag/4 invalidates cached responses for v3/tag and v3/tag/4, but not for v1/tag.
+// Get (without Into) is not cached, because it returns a raw response. Create this with NewCache.
+type Cache struct {
+	APIer
+	defaultTTL time.Duration
+	ttls       map[string]time.Duration
+	mu         sync.Mutex
+	entries    map[string]*cacheEntry
+	writes     uint64 // counts invalidations, so a response fetched during a write is not stored.
+	hits       atomic.Int64
+	misses     atomic.Int64
+}
+
+// CacheStats contains the counters from a Cache.
+type CacheStats struct {
+	Hits    int64 // Requests answered from the cache.
+	Misses  int64 // Cacheable requests sent to the app.
+	Entries int   // Responses currently stored, including expired ones not yet removed.
+}
+
+type cacheEntry struct {
+	resource string
+	data     []byte
+	expires  time.Time
+}
+
+// Cache must satisfy the APIer interface.
+var _ APIer = (*Cache)(nil)
+
+// NewCache returns an APIer that caches GetInto responses from api. The ttls map is keyed by API path,
+// without the /api/vN prefix, like "tag" or "system/status". Keys are case-insensitive, and the
+// longest matching key wins, so "system/status" also matches "system/status/more".
+// Paths that are not in the map are cached for defaultTTL. Pass 0 for defaultTTL to only cache paths in ttls.
+func NewCache(api APIer, defaultTTL time.Duration, ttls map[string]time.Duration) *Cache {
+	cache := &Cache{
+		APIer:      api,
+		defaultTTL: defaultTTL,
+		ttls:       make(map[string]time.Duration, len(ttls)),
+		entries:    make(map[string]*cacheEntry),
+	}
+
+	for key, ttl := range ttls {
+		cache.ttls[strings.Trim(strings.ToLower(key), "/")] = ttl
+	}
+
+	return cache
+}
+
+// Stats returns the hit and miss counters, and the number of stored responses.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Entries: len(c.entries)}
+}
+
+// Flush removes every cached response. The counters are not reset.
+func (c *Cache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*cacheEntry)
+}
+
+// GetInto returns a cached response if one exists, otherwise it makes the request and caches the response.
+func (c *Cache) GetInto(ctx context.Context, req Request, output interface{}) error {
+	resource, key, apiPath := cachePath(req.URI)
+
+	ttl := c.ttl(apiPath)
+	if ttl <= 0 {
+		return c.APIer.GetInto(ctx, req, output) //nolint:wrapcheck // this is a pass-through.
+	}
+
+	if req.Query != nil {
+		key += "?" + req.Query.Encode()
+	}
+
+	if data := c.get(key); data != nil {
+		c.hits.Add(1)
+		return unmarshalCache(data, output)
+	}
+
+	c.misses.Add(1)
+
+	c.mu.Lock()
+	writes := c.writes
+	c.mu.Unlock()
+
+	var data json.RawMessage
+	if err := c.APIer.GetInto(ctx, req, &data); err != nil {
+		return err //nolint:wrapcheck // this is a pass-through.
+	}
+
+	c.mu.Lock()
+	if writes == c.writes {
+		c.entries[key] = &cacheEntry{resource: resource, data: data, expires: time.Now().Add(ttl)}
+	}
+	c.mu.Unlock()
+
+	return unmarshalCache(data, output)
+}
+
+// Post makes a POST request and invalidates cached responses for the same resource.
+func (c *Cache) Post(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req.URI)
+	return c.APIer.Post(ctx, req) //nolint:wrapcheck // this is a pass-through.
+}
+
+// Put makes a PUT request and invalidates cached responses for the same resource.
+func (c *Cache) Put(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req.URI)
+	return c.APIer.Put(ctx, req) //nolint:wrapcheck // this is a pass-through.
+}
+
+// Delete makes a DELETE request and invalidates cached responses for the same resource.
+func (c *Cache) Delete(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req.URI)
+	return c.APIer.Delete(ctx, req) //nolint:wrapcheck // this is a pass-through.
+}
+
+// PostInto makes a POST request and invalidates cached responses for the same resource.
+func (c *Cache) PostInto(ctx context.Context, req Request, output interface{}) error {
+	defer c.invalidate(req.URI)
+	return c.APIer.PostInto(ctx, req, output) //nolint:wrapcheck // this is a pass-through.
+}
+
+// PutInto makes a PUT request and invalidates cached responses for the same resource.
+func (c *Cache) PutInto(ctx context.Context, req Request, output interface{}) error {
+	defer c.invalidate(req.URI)
+	return c.APIer.PutInto(ctx, req, output) //nolint:wrapcheck // this is a pass-through.
+}
+
+// DeleteAny makes a DELETE request and invalidates cached responses for the same resource.
+func (c *Cache) DeleteAny(ctx context.Context, req Request) error {
+	defer c.invalidate(req.URI)
+	return c.APIer.DeleteAny(ctx, req) //nolint:wrapcheck // this is a pass-through.
+}
+
+// get returns the cached data for a key, or nil if it's missing or expired.
+func (c *Cache) get(key string) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil
+	}
+
+	return entry.data
+}
+
+// invalidate removes every cached response for the resource in uri.
+func (c *Cache) invalidate(uri string) {
+	resource, _, _ := cachePath(uri)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.writes++
+
+	for key, entry := range c.entries {
+		if entry.resource == resource {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// ttl returns the TTL for the longest matching path in the ttls map.
+func (c *Cache) ttl(apiPath string) time.Duration {
+	for check := apiPath; check != "." && check != "/" && check != ""; check = path.Dir(check) {
+		if ttl, ok := c.ttls[check]; ok {
+			return ttl
+		}
+	}
+
+	return c.defaultTTL
+}
+
+// cachePath returns the resource, the cache key and the path used to find the TTL, all lower-case.
+// The resource and key keep the API version, so the same path in two API versions is cached separately.
+// An input of /api/v3/qualityProfile/1 returns "v3/qualityprofile", "v3/qualityprofile/1" and "qualityprofile/1".
+func cachePath(uri string) (string, string, string) {
+	key := strings.TrimPrefix(strings.Trim(strings.ToLower(uri), "/"), API+"/")
+	version, apiPath := "", key
+
+	if idx := strings.Index(key, "/"); idx > 1 && key[0] == 'v' && key[1] >= '0' && key[1] <= '9' {
+		version, apiPath = key[:idx+1], key[idx+1:]
+	}
+
+	resource, _, _ := strings.Cut(apiPath, "/")
+
+	return version + resource, key, apiPath
+}
+
+func unmarshalCache(data []byte, output interface{}) error {
+	if output == nil {
+		return fmt.Errorf("this is a Starr library bug: %w", ErrNilInterface)
+	}
+
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("decoding cached Starr JSON response body: %w", err)
+	}
+
+	return nil
+}
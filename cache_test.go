@@ -0,0 +1,56 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	var requests int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		_, _ = w.Write([]byte(`[{"id":1,"label":"movies"}]`))
+	}))
+	defer server.Close()
+
+	cache := starr.NewCache(starr.New("mockAPIkey", server.URL, 0), 0, map[string]time.Duration{"Tag": time.Minute})
+	ctx := context.Background()
+
+	var tags []*starr.Tag
+
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v3/tag"}, &tags))
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "/api/v3/tag"}, &tags))
+	assert.Equal(t, []*starr.Tag{{ID: 1, Label: "movies"}}, tags)
+	assert.Equal(t, starr.CacheStats{Hits: 1, Misses: 1, Entries: 1}, cache.Stats())
+
+	// Paths without a TTL are not cached, and not counted.
+	var status interface{}
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v3/system/status"}, &status))
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v3/system/status"}, &status))
+	assert.Equal(t, starr.CacheStats{Hits: 1, Misses: 1, Entries: 1}, cache.Stats())
+
+	// A write to the same resource invalidates it.
+	require.NoError(t, cache.DeleteAny(ctx, starr.Request{URI: "v3/tag/1"}))
+	assert.Equal(t, 0, cache.Stats().Entries)
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v3/tag"}, &tags))
+	assert.Equal(t, starr.CacheStats{Hits: 1, Misses: 2, Entries: 1}, cache.Stats())
+
+	// Another API version is cached separately, and is not invalidated by writes to this one.
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v1/tag"}, &tags))
+	assert.Equal(t, starr.CacheStats{Hits: 1, Misses: 3, Entries: 2}, cache.Stats())
+	require.NoError(t, cache.PutInto(ctx, starr.Request{URI: "/api/v3/tag/1"}, &tags))
+	require.NoError(t, cache.GetInto(ctx, starr.Request{URI: "v1/tag"}, &tags))
+	assert.Equal(t, starr.CacheStats{Hits: 2, Misses: 3, Entries: 1}, cache.Stats())
+	assert.Equal(t, int64(7), atomic.LoadInt64(&requests))
+}
@@ -0,0 +1,72 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Command statuses. These are the Status values in the CommandResponse from each app.
+const (
+	CommandQueued    = "queued"
+	CommandStarted   = "started"
+	CommandCompleted = "completed"
+	CommandFailed    = "failed"
+	CommandAborted   = "aborted"
+	CommandCancelled = "cancelled"
+	CommandOrphaned  = "orphaned"
+)
+
+// DefaultCommandInterval is how often methods that wait for a command, like RenameFiles, check its status.
+const DefaultCommandInterval = time.Second
+
+// ErrCommandFailed is returned when a command finishes with a status other than completed.
+var ErrCommandFailed = fmt.Errorf("command did not complete")
+
+// CommandDone returns true if a command status is final, and the command is no longer running.
+func CommandDone(status string) bool {
+	switch status {
+	case CommandCompleted, CommandFailed, CommandAborted, CommandCancelled, CommandOrphaned:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForCommand calls status every interval until it returns a final command status, an error,
+// or the context is cancelled. The status function returns the command's status and message.
+// Returns ErrCommandFailed (with the message) if the command finishes with a status other than completed.
+// Each app has a WaitForCommand method that wraps this; you probably want one of those.
+func WaitForCommand(
+	ctx context.Context,
+	interval time.Duration,
+	status func(context.Context) (string, string, error),
+) error {
+	if interval <= 0 {
+		interval = DefaultCommandInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		current, message, err := status(ctx)
+		if err != nil {
+			return err
+		}
+
+		if CommandDone(current) {
+			if current != CommandCompleted {
+				return fmt.Errorf("%w: %s: %s", ErrCommandFailed, current, message)
+			}
+
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for command: %w", ctx.Err())
+		}
+	}
+}
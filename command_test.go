@@ -0,0 +1,39 @@
+package starr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	statuses := []string{starr.CommandQueued, starr.CommandStarted, starr.CommandCompleted, starr.CommandFailed}
+	calls := 0
+
+	err := starr.WaitForCommand(context.Background(), time.Millisecond, func(context.Context) (string, string, error) {
+		calls++
+		return statuses[calls-1], "", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	err = starr.WaitForCommand(context.Background(), time.Millisecond, func(context.Context) (string, string, error) {
+		return starr.CommandFailed, "disk full", nil
+	})
+	require.ErrorIs(t, err, starr.ErrCommandFailed)
+	assert.Contains(t, err.Error(), "disk full")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	err = starr.WaitForCommand(ctx, time.Millisecond, func(context.Context) (string, string, error) {
+		return starr.CommandStarted, "", nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,295 @@
+package debuglog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+/* This file contains the code that masks secrets before they are logged.
+ * Redaction is enabled by default. Set Config.NoRedact to log everything.
+ */
+
+// Mask replaces redacted values in logs.
+const Mask = "********"
+
+// DefaultRedactHeaders are the headers masked when Config.RedactHeaders is nil.
+//
+//nolint:gochecknoglobals
+var DefaultRedactHeaders = []string{"X-Api-Key", "Authorization", "Set-Cookie", "Cookie"}
+
+// DefaultRedactFields are the JSON fields masked when Config.RedactFields is nil.
+// These cover the download client, indexer, notification and import list settings in the starr apps.
+//
+//nolint:gochecknoglobals
+var DefaultRedactFields = []string{
+	"apiKey", "password", "passkey", "passphrase", "token", "accessToken", "accessTokenSecret",
+	"refreshToken", "authToken", "secret", "consumerSecret", "cookie", "rssPasskey",
+}
+
+// DefaultRedactQuery are the query string (and form) parameters masked when Config.RedactQuery is nil.
+//
+//nolint:gochecknoglobals
+var DefaultRedactQuery = []string{"apikey", "api_key", "password", "token"}
+
+// Redactor masks secrets in headers, URLs and bodies. A nil Redactor masks nothing.
+// The LoggingRoundTripper creates one from its Config. Create your own with NewRedactor
+// to mask secrets in other output, like test fixtures.
+type Redactor struct {
+	headers map[string]bool // canonical header names.
+	fields  map[string]bool // lower-case field names and dotted paths.
+	query   map[string]bool // lower-case parameter names.
+}
+
+// NewRedactor returns a Redactor for the redaction settings in a Config, or nil if redaction is disabled.
+func NewRedactor(config Config) *Redactor {
+	if config.NoRedact {
+		return nil
+	}
+
+	headers, fields, query := config.RedactHeaders, config.RedactFields, config.RedactQuery
+	if headers == nil {
+		headers = DefaultRedactHeaders
+	}
+
+	if fields == nil {
+		fields = DefaultRedactFields
+	}
+
+	if query == nil {
+		query = DefaultRedactQuery
+	}
+
+	red := &Redactor{
+		headers: make(map[string]bool, len(headers)),
+		fields:  make(map[string]bool, len(fields)),
+		query:   make(map[string]bool, len(query)),
+	}
+
+	for _, header := range headers {
+		red.headers[http.CanonicalHeaderKey(header)] = true
+	}
+
+	for _, field := range fields {
+		red.fields[strings.ToLower(field)] = true
+	}
+
+	for _, param := range query {
+		red.query[strings.ToLower(param)] = true
+	}
+
+	return red
+}
+
+// Header returns the value to log for a header.
+func (r *Redactor) Header(name, value string) string {
+	if r != nil && r.headers[http.CanonicalHeaderKey(name)] {
+		return Mask
+	}
+
+	return value
+}
+
+// URL returns the URL to log, with redacted query parameters masked.
+func (r *Redactor) URL(input *url.URL) string {
+	if r == nil || input.RawQuery == "" {
+		return input.String()
+	}
+
+	output := *input
+	output.RawQuery = r.Form(input.RawQuery)
+
+	return output.String()
+}
+
+// Form masks redacted parameters in a query string or form body.
+// Returns the input if it cannot be parsed, or contains nothing to mask.
+func (r *Redactor) Form(input string) string {
+	values, err := url.ParseQuery(input)
+	if r == nil || err != nil {
+		return input
+	}
+
+	masked := false
+
+	for key, vals := range values {
+		if !r.query[strings.ToLower(key)] && !r.fields[strings.ToLower(key)] {
+			continue
+		}
+
+		for idx := range vals {
+			vals[idx] = Mask
+		}
+
+		masked = true
+	}
+
+	if !masked {
+		return input
+	}
+
+	// Encode escapes the mask; unescape it so the log is readable.
+	return strings.ReplaceAll(values.Encode(), url.QueryEscape(Mask), Mask)
+}
+
+// JSON masks redacted fields in a JSON body. Returns the input if it is not valid JSON.
+// Field names match anywhere in the document, and dotted paths (like settings.password) match from the top.
+// Array indexes are not part of a path. The starr apps' {"name": "x", "value": "y"} settings fields
+// are matched by the name, so a "password" setting has its value masked.
+// Masked values are replaced in place, so the key order, formatting and escaping of the input are kept.
+func (r *Redactor) JSON(input []byte) []byte {
+	if r == nil || len(input) == 0 {
+		return input
+	}
+
+	walk := &jsonWalker{Redactor: r, input: input, decoder: json.NewDecoder(bytes.NewReader(input))}
+	if _, _, err := walk.value("", false); err != nil || len(walk.masks) == 0 {
+		return input
+	}
+
+	return walk.replace()
+}
+
+// jsonWalker reads the tokens in a JSON document, and records the position of every value to mask.
+type jsonWalker struct {
+	*Redactor
+	input   []byte
+	decoder *json.Decoder
+	masks   []jsonSpan
+}
+
+// jsonSpan is the position of a value in the input.
+type jsonSpan struct {
+	start, end int
+}
+
+// value reads one value, and returns its position and first token.
+// Nothing inside the value is recorded when skip is true; this is used for values that are masked as a whole.
+func (w *jsonWalker) value(path string, skip bool) (jsonSpan, json.Token, error) {
+	before := w.decoder.InputOffset()
+
+	token, err := w.decoder.Token()
+	if err != nil {
+		return jsonSpan{}, nil, fmt.Errorf("reading JSON: %w", err)
+	}
+
+	// The offset before the token may include the separator and whitespace in front of it.
+	span := jsonSpan{start: int(before), end: int(w.decoder.InputOffset())}
+	for span.start < span.end && bytes.IndexByte([]byte(" \t\r\n:,"), w.input[span.start]) >= 0 {
+		span.start++
+	}
+
+	switch token {
+	case json.Delim('{'):
+		err = w.object(path, skip)
+	case json.Delim('['):
+		err = w.array(path, skip)
+	}
+
+	span.end = int(w.decoder.InputOffset())
+
+	return span, token, err
+}
+
+// object reads the members of an object, after the opening brace.
+func (w *jsonWalker) object(path string, skip bool) error {
+	var (
+		name  string
+		value *jsonSpan
+	)
+
+	for w.decoder.More() {
+		token, err := w.decoder.Token()
+		if err != nil {
+			return fmt.Errorf("reading JSON: %w", err)
+		}
+
+		key, _ := token.(string)
+		masked := !skip && w.match(key, join(path, key))
+
+		span, token, err := w.value(join(path, key), skip || masked)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case masked:
+			if token != nil && token != "" {
+				w.masks = append(w.masks, span)
+			}
+		case key == "name":
+			name, _ = token.(string)
+		case key == "value" && token != nil && token != "":
+			value = &span
+		}
+	}
+
+	// This is a settings field, like {"name": "password", "value": "secret"}.
+	if !skip && name != "" && value != nil && w.match(name, join(path, name)) {
+		w.masks = append(w.masks, *value)
+	}
+
+	return w.end()
+}
+
+// array reads the items in an array, after the opening bracket.
+func (w *jsonWalker) array(path string, skip bool) error {
+	for w.decoder.More() {
+		if _, _, err := w.value(path, skip); err != nil {
+			return err
+		}
+	}
+
+	return w.end()
+}
+
+// end reads the closing brace or bracket.
+func (w *jsonWalker) end() error {
+	if _, err := w.decoder.Token(); err != nil {
+		return fmt.Errorf("reading JSON: %w", err)
+	}
+
+	return nil
+}
+
+// replace returns a copy of the input with every recorded value masked.
+// A settings value that contains other masked values is masked as a whole.
+func (w *jsonWalker) replace() []byte {
+	sort.Slice(w.masks, func(i, j int) bool { return w.masks[i].start < w.masks[j].start })
+
+	var (
+		output bytes.Buffer
+		last   int
+	)
+
+	for _, span := range w.masks {
+		if span.start < last {
+			continue // inside a value that is already masked.
+		}
+
+		output.Write(w.input[last:span.start])
+		output.WriteString(`"` + Mask + `"`)
+		last = span.end
+	}
+
+	output.Write(w.input[last:])
+
+	return output.Bytes()
+}
+
+// match returns true if a field name or its dotted path is redacted.
+func (r *Redactor) match(name, path string) bool {
+	return r.fields[strings.ToLower(name)] || r.fields[strings.ToLower(path)]
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -1,5 +1,6 @@
 // Package debuglog provides a RoundTripper you can put into
 // an HTTP client Transport to log requests made with that client.
+// API keys, passwords and cookies are masked in the logs by default; see Config.
 package debuglog
 
 import (
@@ -15,6 +16,12 @@ type Config struct {
 	MaxBody int                          // Limit payloads to this many bytes. 0=unlimited
 	Debugf  func(string, ...interface{}) // This is where logs go.
 	Caller  Caller                       // This can be used for byte counters.
+	// Redaction is enabled by default. These lists are case-insensitive, and replace the defaults when not nil.
+	// Pass an empty (not nil) list to mask nothing of that kind. See the Default variables for the defaults.
+	RedactHeaders []string // Response headers to mask, like X-Api-Key.
+	RedactFields  []string // JSON field names or dotted paths to mask in bodies, like apiKey or settings.password.
+	RedactQuery   []string // Query string (and form body) parameters to mask in URLs, like apikey.
+	NoRedact      bool     // Set this to true to log secrets. Overrides the lists above.
 }
 
 // Caller is a callback function you may use to collect statistics.
@@ -24,6 +31,7 @@ type Caller func(status, method string, sentBytes, rcvdBytes int, err error)
 type LoggingRoundTripper struct {
 	next   http.RoundTripper // The next Transport to call after logging.
 	config *Config
+	redact *Redactor
 }
 
 type fakeCloser struct {
@@ -31,10 +39,12 @@ type fakeCloser struct {
 	CloseFn func() error
 	Body    *bytes.Buffer
 	Sent    *bytes.Buffer
+	Form    bool // The sent body is form-encoded.
 	Method  string
 	URL     string
 	Status  string
 	Header  http.Header
+	redact  *Redactor
 	*Config
 }
 
@@ -51,6 +61,7 @@ func NewLoggingRoundTripper(config Config, next http.RoundTripper) *LoggingRound
 	return &LoggingRoundTripper{
 		next:   next,
 		config: &config,
+		redact: NewRedactor(config),
 	}
 }
 
@@ -88,9 +99,11 @@ func (rt *LoggingRoundTripper) newFakeCloser(resp *http.Response, sent *bytes.Bu
 		Body:    &buf,
 		Method:  resp.Request.Method,
 		Status:  resp.Status,
-		URL:     resp.Request.URL.String(),
+		URL:     rt.redact.URL(resp.Request.URL),
 		Sent:    sent,
+		Form:    strings.Contains(resp.Request.Header.Get("content-type"), "form"),
 		Header:  resp.Header,
+		redact:  rt.redact,
 		Config:  rt.config,
 	}
 }
@@ -114,24 +127,30 @@ func (f *fakeCloser) logRequest() (int, int) {
 		sentBytes = f.Sent.Len()
 	)
 
-	if f.MaxBody > 0 && sentBytes > f.MaxBody {
-		sent = string(f.Sent.Bytes()[:f.MaxBody]) + " <data truncated>"
+	// Redact before truncating. A truncated body is not valid JSON, and could not be masked.
+	if f.Form {
+		sent = f.redact.Form(f.Sent.String())
 	} else {
-		sent = f.Sent.String()
+		sent = string(f.redact.JSON(f.Sent.Bytes()))
+	}
+
+	if f.MaxBody > 0 && len(sent) > f.MaxBody {
+		sent = sent[:f.MaxBody] + " <data truncated>"
 	}
 
 	switch ctype := f.Header.Get("content-type"); {
 	case !strings.Contains(ctype, "json"):
 		rcvd = "<data not logged, content-type: " + ctype + ">"
-	case f.MaxBody > 0 && rcvdBytes > f.MaxBody:
-		rcvd = string(f.Body.Bytes()[:f.MaxBody]) + " <body truncated>"
 	default:
-		rcvd = f.Body.String()
+		rcvd = string(f.redact.JSON(f.Body.Bytes()))
+		if f.MaxBody > 0 && len(rcvd) > f.MaxBody {
+			rcvd = rcvd[:f.MaxBody] + " <body truncated>"
+		}
 	}
 
 	for header, value := range f.Header {
 		for _, v := range value {
-			headers += header + ": " + v + "\n"
+			headers += header + ": " + f.redact.Header(header, v) + "\n"
 		}
 	}
 
@@ -0,0 +1,87 @@
+package debuglog_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/debuglog"
+)
+
+const testBody = `{"id":1,"name":"SABnzbd","fields":[{"name":"host","value":"localhost"},` +
+	`{"name":"apiKey","value":"secret-key"}],"settings":{"password":"hunter2","username":"me"}}`
+
+func testLog(t *testing.T, config debuglog.Config, uri string) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, _ *http.Request) {
+		resp.Header().Set("Content-Type", "application/json")
+		resp.Header().Set("Set-Cookie", "session=abc123")
+		_, _ = resp.Write([]byte(testBody))
+	}))
+	defer server.Close()
+
+	var logged strings.Builder
+
+	config.Debugf = func(format string, args ...interface{}) { logged.WriteString(fmt.Sprintf(format, args...)) }
+	client := &http.Client{Transport: debuglog.NewLoggingRoundTripper(config, nil)}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+uri, strings.NewReader(testBody)) //nolint:noctx
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	_, _ = io.ReadAll(resp.Body)
+	require.NoError(t, resp.Body.Close())
+
+	return logged.String()
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	logged := testLog(t, debuglog.Config{}, "/api/v3/downloadclient/1?apikey=secret-query&forceSave=true")
+	for _, secret := range []string{"secret-key", "hunter2", "abc123", "secret-query"} {
+		assert.NotContains(t, logged, secret, "a secret was logged")
+	}
+
+	assert.Contains(t, logged, "apikey="+debuglog.Mask+"&forceSave=true")
+	assert.Contains(t, logged, `{"name":"apiKey","value":"`+debuglog.Mask+`"}`)
+	assert.Contains(t, logged, `"username":"me"`)
+	assert.Contains(t, logged, "localhost")
+
+	// A dotted path only matches from the top of the document.
+	logged = testLog(t, debuglog.Config{RedactFields: []string{"settings.username"}}, "/")
+	assert.Contains(t, logged, "hunter2")
+	assert.NotContains(t, logged, `"username":"me"`)
+
+	logged = testLog(t, debuglog.Config{NoRedact: true}, "/?apikey=secret-query")
+	for _, secret := range []string{"secret-key", "hunter2", "abc123", "secret-query"} {
+		assert.Contains(t, logged, secret, "redaction was not disabled")
+	}
+}
+
+func TestRedactorJSON(t *testing.T) {
+	t.Parallel()
+
+	redact := debuglog.NewRedactor(debuglog.Config{})
+	// Key order, indentation and characters like <>& are kept; only the secrets change.
+	input := "{\n  \"zeta\": \"a<b>&c\",\n  \"password\": \"hunter2\",\n  \"fields\": [\n" +
+		"    {\"value\": {\"token\": \"x\"}, \"name\": \"apiKey\"},\n    {\"name\": \"apiKey\", \"value\": \"\"}\n" +
+		"  ],\n  \"token\": null,\n  \"secret\": {\"nested\": [1, 2]}\n}\n"
+	expect := "{\n  \"zeta\": \"a<b>&c\",\n  \"password\": \"" + debuglog.Mask + "\",\n  \"fields\": [\n" +
+		"    {\"value\": \"" + debuglog.Mask + "\", \"name\": \"apiKey\"},\n    {\"name\": \"apiKey\", \"value\": \"\"}\n" +
+		"  ],\n  \"token\": null,\n  \"secret\": \"" + debuglog.Mask + "\"\n}\n"
+	assert.Equal(t, expect, string(redact.JSON([]byte(input))))
+
+	// Invalid JSON, and JSON with nothing to mask, are returned unchanged.
+	for _, input := range []string{`{"password": "hunter2"`, `{"b": 1, "a": "<&>"}`, `"text"`} {
+		assert.Equal(t, input, string(redact.JSON([]byte(input))))
+	}
+}
@@ -0,0 +1,139 @@
+package starr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* This file contains the code to find out which app (and version) is behind a URL. */
+
+// ErrUnknownApp is returned by Detect when no system status endpoint answers at the URL.
+var ErrUnknownApp = fmt.Errorf("could not detect a starr app")
+
+// detectAPIVersions are the API versions Detect tries when /api does not list them.
+//
+//nolint:gochecknoglobals
+var detectAPIVersions = []string{"v3", "v1"}
+
+// AppInfo is the data returned by Detect.
+type AppInfo struct {
+	// App is the detected app, like Sonarr or Radarr. Apps this library does not know use the name they report.
+	App App
+	// InstanceName is the name set in the app's settings. Usually the same as the app name.
+	InstanceName string
+	// Version is the full app version, like 4.0.1.929.
+	Version string
+	// Branch is the update branch, like main or develop.
+	Branch string
+	// APIVersion is the API version the app recommends, and the one Detect used, like v3.
+	APIVersion string
+	// APIVersions is every API version the app supports, with APIVersion first.
+	APIVersions []string
+}
+
+// apiInfo is the data from the /api endpoint.
+type apiInfo struct {
+	Current    string   `json:"current"`
+	Deprecated []string `json:"deprecated"`
+}
+
+// detectStatus is the data Detect needs from the system/status endpoint.
+type detectStatus struct {
+	AppName      string `json:"appName"`
+	InstanceName string `json:"instanceName"`
+	Version      string `json:"version"`
+	Branch       string `json:"branch"`
+}
+
+// Detect finds out which app is behind the URL in a Config (or other APIer), and which API versions it supports.
+// This asks /api for the supported API versions, and falls back to trying v3 and v1 if that fails.
+// Then it reads the app name, version and branch from system/status. The API key must be valid.
+func Detect(ctx context.Context, api APIer) (*AppInfo, error) {
+	versions := detectAPIVersions
+
+	var info apiInfo
+	if err := api.GetInto(ctx, Request{URI: ""}, &info); err == nil && info.Current != "" {
+		versions = append([]string{info.Current}, info.Deprecated...)
+	}
+
+	var lastErr error
+
+	for _, version := range versions {
+		var status detectStatus
+
+		req := Request{URI: version + "/system/status"}
+		if err := api.GetInto(ctx, req, &status); err != nil {
+			lastErr = fmt.Errorf("api.Get(%s): %w", &req, err)
+			continue
+		}
+
+		if status.AppName == "" {
+			lastErr = fmt.Errorf("api.Get(%s): missing app name: %w", &req, ErrUnknownApp)
+			continue
+		}
+
+		output := &AppInfo{
+			App:          detectApp(status.AppName),
+			InstanceName: status.InstanceName,
+			Version:      status.Version,
+			Branch:       status.Branch,
+			APIVersion:   version,
+			APIVersions:  versions,
+		}
+
+		if info.Current == "" {
+			output.APIVersions = []string{version}
+		}
+
+		return output, nil
+	}
+
+	if errors.Is(lastErr, ErrUnknownApp) {
+		return nil, lastErr
+	}
+
+	return nil, fmt.Errorf("%w: %w", ErrUnknownApp, lastErr)
+}
+
+// detectApp turns an app name from system/status into an App.
+func detectApp(name string) App {
+	for _, app := range []App{Lidarr, Prowlarr, Radarr, Readarr, Sonarr} {
+		if strings.EqualFold(app.String(), name) {
+			return app
+		}
+	}
+
+	return App(name)
+}
+
+// Major returns the major version of the app, like 4 for Sonarr 4.0.1.929. Returns 0 if the version is invalid.
+func (a *AppInfo) Major() int {
+	major, _, _ := strings.Cut(a.Version, ".")
+	val, _ := strconv.Atoi(major) //nolint:errcheck // invalid versions are 0.
+
+	return val
+}
+
+// AtLeast returns true if the app's major version is at least major.
+// Use this to check for features that only exist in newer versions, like custom formats in Sonarr v4.
+func (a *AppInfo) AtLeast(major int) bool {
+	return a != nil && a.Major() >= major
+}
+
+// SupportsAPI returns true if the app supports an API version, like v3.
+func (a *AppInfo) SupportsAPI(version string) bool {
+	if a == nil {
+		return false
+	}
+
+	for _, supported := range a.APIVersions {
+		if strings.EqualFold(supported, version) {
+			return true
+		}
+	}
+
+	return false
+}
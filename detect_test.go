@@ -0,0 +1,71 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api":
+			_, _ = w.Write([]byte(`{"current":"v3","deprecated":[]}`))
+		case "/api/v3/system/status":
+			_, _ = w.Write([]byte(`{"appName":"Sonarr","instanceName":"Sonarr 4K","version":"4.0.1.929","branch":"main"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	info, err := starr.Detect(context.Background(), starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, err)
+	assert.Equal(t, &starr.AppInfo{
+		App:          starr.Sonarr,
+		InstanceName: "Sonarr 4K",
+		Version:      "4.0.1.929",
+		Branch:       "main",
+		APIVersion:   "v3",
+		APIVersions:  []string{"v3"},
+	}, info)
+	assert.Equal(t, 4, info.Major())
+	assert.True(t, info.AtLeast(4))
+	assert.False(t, info.AtLeast(5))
+	assert.True(t, info.SupportsAPI("V3"))
+	assert.False(t, info.SupportsAPI("v1"))
+}
+
+func TestDetectFallback(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/system/status" {
+			_, _ = w.Write([]byte(`{"appName":"Lidarr","version":"1.2.6.3313","branch":"master"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	info, err := starr.Detect(context.Background(), starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, err)
+	assert.Equal(t, starr.Lidarr, info.App)
+	assert.Equal(t, "v1", info.APIVersion)
+	assert.Equal(t, []string{"v1"}, info.APIVersions)
+
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+
+	_, err = starr.Detect(context.Background(), starr.New("mockAPIkey", notFound.URL, 0))
+	require.ErrorIs(t, err, starr.ErrUnknownApp)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+}
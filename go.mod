@@ -2,12 +2,14 @@ module golift.io/starr
 
 go 1.25.0
 
-require golang.org/x/net v0.55.0 // publicsuffix, cookiejar.
+require (
+	golang.org/x/net v0.55.0 // publicsuffix, cookiejar.
+	gopkg.in/yaml.v3 v3.0.1 // config loader.
+)
 
 // All of this is for the tests.
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/testify v1.8.0 // assert!
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
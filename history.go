@@ -0,0 +1,340 @@
+package starr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/* This file contains types and helpers to decode the data attached to history records.
+ * The apps store this data as a map of strings, and the keys depend on the event type.
+ */
+
+// ErrHistoryEvent is returned when a typed history accessor is used on a record with a different event type.
+var ErrHistoryEvent = fmt.Errorf("history record has a different event type")
+
+// HistoryData is the raw data attached to a history record. Every value is a string.
+// The keys depend on the record's event type, and on the app. Use the methods to parse values.
+type HistoryData map[string]string
+
+// ParseHistoryData decodes the raw data from a history record.
+// A nil or empty input returns an empty (non-nil) map.
+func ParseHistoryData(raw json.RawMessage) (HistoryData, error) {
+	data := make(HistoryData)
+	if len(raw) == 0 || string(raw) == "null" {
+		return data, nil
+	}
+
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("decoding history data: %w", err)
+	}
+
+	for key, val := range values {
+		switch val := val.(type) {
+		case nil:
+		case string:
+			data[key] = val
+		default:
+			data[key] = fmt.Sprint(val)
+		}
+	}
+
+	return data, nil
+}
+
+// HistoryEvent is the event type and raw data from a history record.
+// The apps' history records use this to provide their typed event accessors.
+// The raw data is only kept when a record is decoded from JSON, so a HistoryEvent
+// for a record built in Go has no data, and its accessors return empty results.
+type HistoryEvent struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// DecodeHistoryRecord decodes a history record into record, and returns the record's raw data.
+// Pass a pointer to a type without an UnmarshalJSON method, or this recurses.
+func DecodeHistoryRecord(input []byte, record interface{}) (json.RawMessage, error) {
+	var raw struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(input, record); err != nil {
+		return nil, fmt.Errorf("decoding history record: %w", err)
+	}
+
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return nil, fmt.Errorf("decoding history record data: %w", err)
+	}
+
+	return raw.Data, nil
+}
+
+// Data returns the record's data if the record has the provided event type.
+// Returns ErrHistoryEvent if the record has a different event type.
+func (e HistoryEvent) Data(eventType string) (HistoryData, error) {
+	if e.Type != eventType {
+		return nil, fmt.Errorf("%w: %s is not %s", ErrHistoryEvent, e.Type, eventType)
+	}
+
+	return ParseHistoryData(e.Raw)
+}
+
+// Grabbed returns the typed data from a grabbed history record.
+func (e HistoryEvent) Grabbed() (*HistoryGrabbed, error) {
+	data, err := e.Data("grabbed")
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Grabbed(), nil
+}
+
+// Imported returns the typed data from an imported history record.
+// The event type is app-specific, like downloadFolderImported or trackFileImported.
+func (e HistoryEvent) Imported(eventType string) (*HistoryImported, error) {
+	data, err := e.Data(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Imported(), nil
+}
+
+// DownloadFailed returns the typed data from a download failed history record.
+func (e HistoryEvent) DownloadFailed() (*HistoryDownloadFailed, error) {
+	data, err := e.Data("downloadFailed")
+	if err != nil {
+		return nil, err
+	}
+
+	return data.DownloadFailed(), nil
+}
+
+// FileDeleted returns the typed data from a file deleted history record.
+// The event type is app-specific, like movieFileDeleted or episodeFileDeleted.
+func (e HistoryEvent) FileDeleted(eventType string) (*HistoryFileDeleted, error) {
+	data, err := e.Data(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.FileDeleted(), nil
+}
+
+// FileRenamed returns the typed data from a file renamed history record.
+// The event type is app-specific, like movieFileRenamed or episodeFileRenamed.
+func (e HistoryEvent) FileRenamed(eventType string) (*HistoryFileRenamed, error) {
+	data, err := e.Data(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.FileRenamed(), nil
+}
+
+// DownloadIgnored returns the typed data from a download ignored history record.
+func (e HistoryEvent) DownloadIgnored() (*HistoryDownloadIgnored, error) {
+	data, err := e.Data("downloadIgnored")
+	if err != nil {
+		return nil, err
+	}
+
+	return data.DownloadIgnored(), nil
+}
+
+// String returns the value for a key, or an empty string if it does not exist.
+func (d HistoryData) String(key string) string {
+	return d[key]
+}
+
+// Int64 returns the value for a key as an integer. Missing or invalid values return 0.
+func (d HistoryData) Int64(key string) int64 {
+	val, _ := strconv.ParseInt(d[key], 10, 64) //nolint:errcheck // invalid values are zero.
+	return val
+}
+
+// Float64 returns the value for a key as a float. Missing or invalid values return 0.
+func (d HistoryData) Float64(key string) float64 {
+	val, _ := strconv.ParseFloat(d[key], 64) //nolint:errcheck // invalid values are zero.
+	return val
+}
+
+// Bool returns the value for a key as a boolean. Missing or invalid values return false.
+func (d HistoryData) Bool(key string) bool {
+	val, _ := strconv.ParseBool(d[key]) //nolint:errcheck // invalid values are false.
+	return val
+}
+
+// Time returns the value for a key as a time. Missing or invalid values return a zero time.
+func (d HistoryData) Time(key string) time.Time {
+	val, _ := time.Parse(time.RFC3339Nano, d[key]) //nolint:errcheck // invalid values are zero.
+	return val
+}
+
+// HistoryGrabbed is the data attached to a grabbed history record.
+type HistoryGrabbed struct {
+	Indexer            string
+	NzbInfoURL         string
+	ReleaseGroup       string
+	Age                int64
+	AgeHours           float64
+	AgeMinutes         float64
+	PublishedDate      time.Time
+	DownloadClient     string
+	DownloadClientName string
+	DownloadURL        string
+	GUID               string
+	Protocol           string
+	TorrentInfoHash    string
+	Size               int64
+	CustomFormatScore  int64
+	IndexerFlags       int64
+	DownloadForced     bool
+	// Data contains every value, including the app-specific ones like tvdbId and tmdbId.
+	Data HistoryData
+}
+
+// HistoryImported is the data attached to a download folder imported history record.
+type HistoryImported struct {
+	FileID             int64
+	DroppedPath        string
+	ImportedPath       string
+	DownloadClient     string
+	DownloadClientName string
+	ReleaseGroup       string
+	Size               int64
+	CustomFormatScore  int64
+	// Data contains every value, including the app-specific ones.
+	Data HistoryData
+}
+
+// HistoryDownloadFailed is the data attached to a download failed history record.
+type HistoryDownloadFailed struct {
+	Message            string
+	Indexer            string
+	DownloadClient     string
+	DownloadClientName string
+	ReleaseGroup       string
+	Size               int64
+	// Data contains every value, including the app-specific ones.
+	Data HistoryData
+}
+
+// HistoryFileDeleted is the data attached to a file deleted history record.
+type HistoryFileDeleted struct {
+	Reason            string
+	ReleaseGroup      string
+	Size              int64
+	CustomFormatScore int64
+	// Data contains every value, including the app-specific ones.
+	Data HistoryData
+}
+
+// HistoryFileRenamed is the data attached to a file renamed history record.
+type HistoryFileRenamed struct {
+	SourcePath         string
+	SourceRelativePath string
+	Path               string
+	RelativePath       string
+	// Data contains every value, including the app-specific ones.
+	Data HistoryData
+}
+
+// HistoryDownloadIgnored is the data attached to a download ignored history record.
+type HistoryDownloadIgnored struct {
+	Message            string
+	DownloadClient     string
+	DownloadClientName string
+	ReleaseGroup       string
+	Size               int64
+	// Data contains every value, including the app-specific ones.
+	Data HistoryData
+}
+
+// Grabbed converts history data into grabbed event data.
+func (d HistoryData) Grabbed() *HistoryGrabbed {
+	return &HistoryGrabbed{
+		Indexer:            d.String("indexer"),
+		NzbInfoURL:         d.String("nzbInfoUrl"),
+		ReleaseGroup:       d.String("releaseGroup"),
+		Age:                d.Int64("age"),
+		AgeHours:           d.Float64("ageHours"),
+		AgeMinutes:         d.Float64("ageMinutes"),
+		PublishedDate:      d.Time("publishedDate"),
+		DownloadClient:     d.String("downloadClient"),
+		DownloadClientName: d.String("downloadClientName"),
+		DownloadURL:        d.String("downloadUrl"),
+		GUID:               d.String("guid"),
+		Protocol:           d.String("protocol"),
+		TorrentInfoHash:    d.String("torrentInfoHash"),
+		Size:               d.Int64("size"),
+		CustomFormatScore:  d.Int64("customFormatScore"),
+		IndexerFlags:       d.Int64("indexerFlags"),
+		DownloadForced:     d.Bool("downloadForced"),
+		Data:               d,
+	}
+}
+
+// Imported converts history data into download folder imported event data.
+func (d HistoryData) Imported() *HistoryImported {
+	return &HistoryImported{
+		FileID:             d.Int64("fileId"),
+		DroppedPath:        d.String("droppedPath"),
+		ImportedPath:       d.String("importedPath"),
+		DownloadClient:     d.String("downloadClient"),
+		DownloadClientName: d.String("downloadClientName"),
+		ReleaseGroup:       d.String("releaseGroup"),
+		Size:               d.Int64("size"),
+		CustomFormatScore:  d.Int64("customFormatScore"),
+		Data:               d,
+	}
+}
+
+// DownloadFailed converts history data into download failed event data.
+func (d HistoryData) DownloadFailed() *HistoryDownloadFailed {
+	return &HistoryDownloadFailed{
+		Message:            d.String("message"),
+		Indexer:            d.String("indexer"),
+		DownloadClient:     d.String("downloadClient"),
+		DownloadClientName: d.String("downloadClientName"),
+		ReleaseGroup:       d.String("releaseGroup"),
+		Size:               d.Int64("size"),
+		Data:               d,
+	}
+}
+
+// FileDeleted converts history data into file deleted event data.
+func (d HistoryData) FileDeleted() *HistoryFileDeleted {
+	return &HistoryFileDeleted{
+		Reason:            d.String("reason"),
+		ReleaseGroup:      d.String("releaseGroup"),
+		Size:              d.Int64("size"),
+		CustomFormatScore: d.Int64("customFormatScore"),
+		Data:              d,
+	}
+}
+
+// FileRenamed converts history data into file renamed event data.
+func (d HistoryData) FileRenamed() *HistoryFileRenamed {
+	return &HistoryFileRenamed{
+		SourcePath:         d.String("sourcePath"),
+		SourceRelativePath: d.String("sourceRelativePath"),
+		Path:               d.String("path"),
+		RelativePath:       d.String("relativePath"),
+		Data:               d,
+	}
+}
+
+// DownloadIgnored converts history data into download ignored event data.
+func (d HistoryData) DownloadIgnored() *HistoryDownloadIgnored {
+	return &HistoryDownloadIgnored{
+		Message:            d.String("message"),
+		DownloadClient:     d.String("downloadClient"),
+		DownloadClientName: d.String("downloadClientName"),
+		ReleaseGroup:       d.String("releaseGroup"),
+		Size:               d.Int64("size"),
+		Data:               d,
+	}
+}
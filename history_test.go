@@ -0,0 +1,40 @@
+package starr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestHistoryEvent(t *testing.T) {
+	t.Parallel()
+
+	var record struct {
+		EventType string `json:"eventType"`
+	}
+
+	raw, err := starr.DecodeHistoryRecord([]byte(`{"eventType":"grabbed","data":{"indexer":"x","size":"12"}}`), &record)
+	require.NoError(t, err)
+	assert.Equal(t, "grabbed", record.EventType)
+
+	grabbed, err := starr.HistoryEvent{Type: record.EventType, Raw: raw}.Grabbed()
+	require.NoError(t, err)
+	assert.Equal(t, "x", grabbed.Indexer)
+	assert.Equal(t, int64(12), grabbed.Size)
+
+	_, err = starr.HistoryEvent{Type: record.EventType, Raw: raw}.FileDeleted("movieFileDeleted")
+	assert.ErrorIs(t, err, starr.ErrHistoryEvent)
+
+	// A record built in Go has no raw data, so the accessors return empty results.
+	grabbed, err = starr.HistoryEvent{Type: "grabbed"}.Grabbed()
+	require.NoError(t, err)
+	assert.Equal(t, &starr.HistoryGrabbed{Data: starr.HistoryData{}}, grabbed)
+
+	var syntaxErr *json.SyntaxError
+
+	_, err = starr.DecodeHistoryRecord([]byte(`{"data":`), &record)
+	assert.ErrorAs(t, err, &syntaxErr)
+}
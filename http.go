@@ -59,11 +59,21 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 		httpReq.URL.RawQuery = req.Query.Encode()
 	}
 
+	release, err := c.limits.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.Client.Do(httpReq)
 	if err != nil {
+		release()
 		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
 	}
 
+	if c.limits != nil {
+		resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		return nil, parseNon200(resp)
 	}
@@ -97,6 +107,10 @@ func parseNon200(resp *http.Response) error {
 		return fmt.Errorf("failed, status: %s: %w: %s (%s)", resp.Status, ErrInvalidStatusCode, errMsg.Msg, errMsg.Name)
 	}
 
+	if valErr := parseValidation(resp.Status, reply); valErr != nil {
+		return valErr
+	}
+
 	const maxSize = 400 // arbitrary max size
 
 	replyStr := string(reply)
@@ -107,6 +121,53 @@ func parseNon200(resp *http.Response) error {
 	return fmt.Errorf("failed, status: %s: %w: %s", resp.Status, ErrInvalidStatusCode, replyStr)
 }
 
+// ValidationError is returned when a Starr app replies with a list of validation failures.
+// This happens when testing providers, and when adding or updating items with bad input.
+// Use errors.As() to retrieve the failures. This error wraps ErrInvalidStatusCode.
+type ValidationError struct {
+	Status   string                // HTTP status returned by the app.
+	Failures []*ValidationFailure  // Populated when a single item failed validation.
+	Results  []*ProviderTestResult // Populated when many providers were tested (testall).
+}
+
+// Error satisfies the error interface.
+func (e *ValidationError) Error() string {
+	var msgs []string
+
+	for _, failure := range e.Failures {
+		msgs = append(msgs, failure.PropertyName+": "+failure.ErrorMessage)
+	}
+
+	for _, result := range e.Results {
+		for _, failure := range result.ValidationFailures {
+			msgs = append(msgs, fmt.Sprintf("id %d: %s: %s", result.ID, failure.PropertyName, failure.ErrorMessage))
+		}
+	}
+
+	return fmt.Sprintf("failed, status: %s: %v: %s", e.Status, ErrInvalidStatusCode, strings.Join(msgs, "; "))
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidStatusCode) to work.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidStatusCode
+}
+
+// parseValidation turns a JSON list of validation failures or provider test results into an error.
+// Returns nil if the reply is not a list of either.
+func parseValidation(status string, reply []byte) *ValidationError {
+	var failures []*ValidationFailure
+	if err := json.Unmarshal(reply, &failures); err == nil && len(failures) > 0 && failures[0].ErrorMessage != "" {
+		return &ValidationError{Status: status, Failures: failures}
+	}
+
+	var results []*ProviderTestResult
+	if err := json.Unmarshal(reply, &results); err == nil && len(results) > 0 && results[0].ID != 0 {
+		return &ValidationError{Status: status, Results: results}
+	}
+
+	return nil
+}
+
 // closeResp should be used to close requests that don't require a response body.
 func closeResp(resp *http.Response) {
 	if resp != nil && resp.Body != nil {
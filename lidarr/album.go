@@ -14,6 +14,9 @@ import (
 
 const bpAlbum = APIver + "/album"
 
+// ErrReleaseNotFound is returned when an album does not have the requested release.
+var ErrReleaseNotFound = fmt.Errorf("album release not found")
+
 // Album is the /api/v1/album endpoint.
 type Album struct {
 	ID             int64            `json:"id,omitempty"`
@@ -221,3 +224,53 @@ func (l *Lidarr) LookupContext(ctx context.Context, term string) ([]*Album, erro
 
 	return output, nil
 }
+
+// DeleteAlbum removes an album.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (l *Lidarr) DeleteAlbum(albumID int64, deleteFiles, importExclude bool) error {
+	return l.DeleteAlbumContext(context.Background(), albumID, deleteFiles, importExclude)
+}
+
+// DeleteAlbumContext removes an album.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (l *Lidarr) DeleteAlbumContext(ctx context.Context, albumID int64, deleteFiles, importExclude bool) error {
+	req := starr.Request{URI: path.Join(bpAlbum, fmt.Sprint(albumID)), Query: make(url.Values)}
+	req.Query.Add("deleteFiles", fmt.Sprint(deleteFiles))
+	req.Query.Add("addImportListExclusion", fmt.Sprint(importExclude))
+
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// MonitorAlbumRelease switches the monitored release of an album to the release with the provided foreign
+// (MusicBrainz) release ID. Every other release of the album is unmonitored. Returns the updated album.
+func (l *Lidarr) MonitorAlbumRelease(albumID int64, foreignReleaseID string) (*Album, error) {
+	return l.MonitorAlbumReleaseContext(context.Background(), albumID, foreignReleaseID)
+}
+
+// MonitorAlbumReleaseContext switches the monitored release of an album to the release with the provided foreign
+// (MusicBrainz) release ID. Every other release of the album is unmonitored. Returns the updated album.
+func (l *Lidarr) MonitorAlbumReleaseContext(ctx context.Context, albumID int64, foreignReleaseID string) (*Album, error) {
+	album, err := l.GetAlbumByIDContext(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+
+	for _, release := range album.Releases {
+		release.Monitored = release.ForeignReleaseID == foreignReleaseID
+		found = found || release.Monitored
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrReleaseNotFound, foreignReleaseID)
+	}
+
+	return l.UpdateAlbumContext(ctx, albumID, album, false)
+}
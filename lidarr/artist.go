@@ -142,3 +142,50 @@ func (l *Lidarr) UpdateArtistContext(ctx context.Context, artist *Artist) (*Arti
 
 	return &output, nil
 }
+
+// GetArtistLookup searches for an artist [in Servarr] using a search term or a MusicBrainz artist ID.
+// Provide a search term or an mbID. If you provide both, mbID is used.
+func (l *Lidarr) GetArtistLookup(term, mbID string) ([]*Artist, error) {
+	return l.GetArtistLookupContext(context.Background(), term, mbID)
+}
+
+// GetArtistLookupContext searches for an artist [in Servarr] using a search term or a MusicBrainz artist ID.
+// Provide a search term or an mbID. If you provide both, mbID is used.
+func (l *Lidarr) GetArtistLookupContext(ctx context.Context, term, mbID string) ([]*Artist, error) {
+	var output []*Artist
+
+	req := starr.Request{URI: path.Join(bpArtist, "lookup"), Query: make(url.Values)}
+	if mbID != "" {
+		req.Query.Add("term", "lidarr:"+mbID)
+	} else {
+		req.Query.Add("term", term)
+	}
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteArtist removes an artist.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (l *Lidarr) DeleteArtist(artistID int64, deleteFiles, importExclude bool) error {
+	return l.DeleteArtistContext(context.Background(), artistID, deleteFiles, importExclude)
+}
+
+// DeleteArtistContext removes an artist.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (l *Lidarr) DeleteArtistContext(ctx context.Context, artistID int64, deleteFiles, importExclude bool) error {
+	req := starr.Request{URI: path.Join(bpArtist, fmt.Sprint(artistID)), Query: make(url.Values)}
+	req.Query.Add("deleteFiles", fmt.Sprint(deleteFiles))
+	req.Query.Add("addImportListExclusion", fmt.Sprint(importExclude))
+
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
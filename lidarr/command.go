@@ -21,6 +21,7 @@ type CommandRequest struct {
 	AlbumID  int64    `json:"albumId,omitempty"`
 	Folders  []string `json:"folders,omitempty"`
 	ArtistID int64    `json:"artistId,omitempty"`
+	Files    []int64  `json:"files,omitempty"` // RenameFiles only
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -106,3 +107,43 @@ func (l *Lidarr) GetCommandStatusContext(ctx context.Context, commandID int64) (
 
 	return &output, nil
 }
+
+// WaitForCommand checks the status of a command every interval until it finishes.
+// Pass the response from SendCommand. Returns the final status of the command.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (l *Lidarr) WaitForCommand(cmd *CommandResponse, interval time.Duration) (*CommandResponse, error) {
+	return l.WaitForCommandContext(context.Background(), cmd, interval)
+}
+
+// WaitForCommandContext checks the status of a command every interval until it finishes, or the context is cancelled.
+// Pass the response from SendCommand. Returns the last known status of the command.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (l *Lidarr) WaitForCommandContext(
+	ctx context.Context,
+	cmd *CommandResponse,
+	interval time.Duration,
+) (*CommandResponse, error) {
+	if cmd == nil || cmd.ID == 0 {
+		return cmd, nil // nothing was sent.
+	}
+
+	output := cmd
+	first := true
+
+	err := starr.WaitForCommand(ctx, interval, func(ctx context.Context) (string, string, error) {
+		if !first {
+			status, err := l.GetCommandStatusContext(ctx, cmd.ID)
+			if err != nil {
+				return "", "", err
+			}
+
+			output = status
+		}
+
+		first = false
+
+		return output.Status, output.Message, nil
+	})
+
+	return output, err //nolint:wrapcheck // the error is already wrapped.
+}
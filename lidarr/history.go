@@ -3,7 +3,9 @@ package lidarr
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -24,6 +26,8 @@ type History struct {
 
 // HistoryRecord is part of the history. Not all items have all Data members.
 // Check EventType for events you need.
+// The typed event accessors, like Grabbed, read data kept when the record is decoded from JSON.
+// They return empty results for a record built in Go.
 type HistoryRecord struct {
 	ID                  int64          `json:"id"`
 	AlbumID             int64          `json:"albumId"`
@@ -56,6 +60,7 @@ type HistoryRecord struct {
 		StatusMessages  string    `json:"statusMessages"`
 		TorrentInfoHash string    `json:"torrentInfoHash"`
 	} `json:"data"`
+	rawData json.RawMessage
 }
 
 // GetHistory returns the Lidarr History (grabs/failures/completed).
@@ -141,3 +146,122 @@ func (l *Lidarr) FailContext(ctx context.Context, historyID int64) error {
 
 	return nil
 }
+
+// GetArtistHistory returns the history for an artist, or one album from an artist if albumID is not 0.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (l *Lidarr) GetArtistHistory(artistID, albumID int64, event starr.Filtering) ([]*HistoryRecord, error) {
+	return l.GetArtistHistoryContext(context.Background(), artistID, albumID, event)
+}
+
+// GetArtistHistoryContext returns the history for an artist, or one album from an artist if albumID is not 0.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (l *Lidarr) GetArtistHistoryContext(
+	ctx context.Context,
+	artistID, albumID int64,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "artist"), Query: make(url.Values)}
+	req.Query.Add("artistId", fmt.Sprint(artistID))
+
+	if albumID != 0 {
+		req.Query.Add("albumId", fmt.Sprint(albumID))
+	}
+
+	return l.getHistoryRecords(ctx, req, event)
+}
+
+// GetHistorySince returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (l *Lidarr) GetHistorySince(since time.Time, event starr.Filtering) ([]*HistoryRecord, error) {
+	return l.GetHistorySinceContext(context.Background(), since, event)
+}
+
+// GetHistorySinceContext returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (l *Lidarr) GetHistorySinceContext(
+	ctx context.Context,
+	since time.Time,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Add("date", since.UTC().Format(time.RFC3339))
+
+	return l.getHistoryRecords(ctx, req, event)
+}
+
+// getHistoryRecords gets a list of history records (not a page), optionally for a single event type.
+func (l *Lidarr) getHistoryRecords(
+	ctx context.Context,
+	req starr.Request,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	if event > 0 {
+		req.Query.Add("eventType", event.Param())
+	}
+
+	var output []*HistoryRecord
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UnmarshalJSON decodes a history record, and keeps a copy of the raw data for the typed event accessors.
+func (h *HistoryRecord) UnmarshalJSON(input []byte) error {
+	type record HistoryRecord // avoids recursion.
+
+	raw, err := starr.DecodeHistoryRecord(input, (*record)(h))
+	h.rawData = raw
+
+	return err //nolint:wrapcheck
+}
+
+// RawData returns every value from the record's data. The keys depend on the event type.
+func (h *HistoryRecord) RawData() (starr.HistoryData, error) {
+	return starr.ParseHistoryData(h.rawData)
+}
+
+// Event returns the record's event type and raw data.
+func (h *HistoryRecord) Event() starr.HistoryEvent {
+	return starr.HistoryEvent{Type: h.EventType, Raw: h.rawData}
+}
+
+// Grabbed returns the typed data from a grabbed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) Grabbed() (*starr.HistoryGrabbed, error) {
+	return h.Event().Grabbed() //nolint:wrapcheck
+}
+
+// TrackFileImported returns the typed data from a track file imported history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) TrackFileImported() (*starr.HistoryImported, error) {
+	return h.Event().Imported("trackFileImported") //nolint:wrapcheck
+}
+
+// DownloadFailed returns the typed data from a download failed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadFailed() (*starr.HistoryDownloadFailed, error) {
+	return h.Event().DownloadFailed() //nolint:wrapcheck
+}
+
+// TrackFileDeleted returns the typed data from a track file deleted history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) TrackFileDeleted() (*starr.HistoryFileDeleted, error) {
+	return h.Event().FileDeleted("trackFileDeleted") //nolint:wrapcheck
+}
+
+// TrackFileRenamed returns the typed data from a track file renamed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) TrackFileRenamed() (*starr.HistoryFileRenamed, error) {
+	return h.Event().FileRenamed("trackFileRenamed") //nolint:wrapcheck
+}
+
+// DownloadIgnored returns the typed data from a download ignored history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadIgnored() (*starr.HistoryDownloadIgnored, error) {
+	return h.Event().DownloadIgnored() //nolint:wrapcheck
+}
@@ -0,0 +1,242 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpImportList = APIver + "/importList"
+
+// ImportListInput is the input for a new or updated import list.
+type ImportListInput struct {
+	EnableAutomaticAdd    bool                `json:"enableAutomaticAdd"`
+	ShouldMonitorExisting bool                `json:"shouldMonitorExisting"`
+	ShouldSearch          bool                `json:"shouldSearch"`
+	QualityProfileID      int64               `json:"qualityProfileId"`
+	MetadataProfileID     int64               `json:"metadataProfileId"`
+	ListOrder             int64               `json:"listOrder"`
+	ID                    int64               `json:"id,omitempty"`
+	ShouldMonitor         string              `json:"shouldMonitor"`
+	MonitorNewItems       string              `json:"monitorNewItems"`
+	RootFolderPath        string              `json:"rootFolderPath"`
+	ListType              string              `json:"listType"`
+	ConfigContract        string              `json:"configContract"`
+	Implementation        string              `json:"implementation"`
+	Name                  string              `json:"name"`
+	Tags                  []int               `json:"tags"`
+	Fields                []*starr.FieldInput `json:"fields"`
+}
+
+// ImportListOutput is the output from the import list methodl.
+type ImportListOutput struct {
+	EnableAutomaticAdd    bool                 `json:"enableAutomaticAdd"`
+	ShouldMonitorExisting bool                 `json:"shouldMonitorExisting"`
+	ShouldSearch          bool                 `json:"shouldSearch"`
+	QualityProfileID      int64                `json:"qualityProfileId"`
+	MetadataProfileID     int64                `json:"metadataProfileId"`
+	ListOrder             int64                `json:"listOrder"`
+	ID                    int64                `json:"id"`
+	ShouldMonitor         string               `json:"shouldMonitor"`
+	MonitorNewItems       string               `json:"monitorNewItems"`
+	RootFolderPath        string               `json:"rootFolderPath"`
+	ListType              string               `json:"listType"`
+	Name                  string               `json:"name"`
+	ImplementationName    string               `json:"implementationName"`
+	Implementation        string               `json:"implementation"`
+	ConfigContract        string               `json:"configContract"`
+	InfoLink              string               `json:"infoLink"`
+	Tags                  []int                `json:"tags"`
+	Fields                []*starr.FieldOutput `json:"fields"`
+}
+
+// GetImportLists returns all configured import listl.
+func (l *Lidarr) GetImportLists() ([]*ImportListOutput, error) {
+	return l.GetImportListsContext(context.Background())
+}
+
+// GetImportListsContext returns all configured import listl.
+func (l *Lidarr) GetImportListsContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: bpImportList}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetImportList returns a single import list.
+func (l *Lidarr) GetImportList(importListID int64) (*ImportListOutput, error) {
+	return l.GetImportListContext(context.Background(), importListID)
+}
+
+// GetImportListContext returns a single import list.
+func (l *Lidarr) GetImportListContext(ctx context.Context, importListID int64) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importListID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddImportList creates a import list.
+func (l *Lidarr) AddImportList(importList *ImportListInput) (*ImportListOutput, error) {
+	return l.AddImportListContext(context.Background(), importList)
+}
+
+// AddImportListContext creates a import list.
+func (l *Lidarr) AddImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(importList); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: bpImportList, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateImportList updates the import list.
+func (l *Lidarr) UpdateImportList(importList *ImportListInput) (*ImportListOutput, error) {
+	return l.UpdateImportListContext(context.Background(), importList)
+}
+
+// UpdateImportListContext updates the import list.
+func (l *Lidarr) UpdateImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(importList); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importList.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteImportList removes a single import list.
+func (l *Lidarr) DeleteImportList(importListID int64) error {
+	return l.DeleteImportListContext(context.Background(), importListID)
+}
+
+// DeleteImportListContext removes a single import list.
+func (l *Lidarr) DeleteImportListContext(ctx context.Context, importListID int64) error {
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importListID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestImportList tests an import list's settings without saving it.
+// Validation failures reported by Lidarr are returned as a list, not as an error.
+func (l *Lidarr) TestImportList(list *ImportListInput) ([]*starr.ValidationFailure, error) {
+	return l.TestImportListContext(context.Background(), list)
+}
+
+// TestImportListContext tests an import list's settings without saving it.
+// Validation failures reported by Lidarr are returned as a list, not as an error.
+func (l *Lidarr) TestImportListContext(ctx context.Context, list *ImportListInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "test"), Body: &body}
+	if err := l.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllImportLists tests all configured import lists and returns the result for each.
+func (l *Lidarr) TestAllImportLists() ([]*starr.ProviderTestResult, error) {
+	return l.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests all configured import lists and returns the result for each.
+func (l *Lidarr) TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := l.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditImportLists updates many import lists at once using the bulk editor.
+func (l *Lidarr) EditImportLists(edit *starr.BulkEditProviders) ([]*ImportListOutput, error) {
+	return l.EditImportListsContext(context.Background(), edit)
+}
+
+// EditImportListsContext updates many import lists at once using the bulk editor.
+func (l *Lidarr) EditImportListsContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*ImportListOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteImportLists removes many import lists at once using the bulk editor.
+func (l *Lidarr) DeleteImportLists(ids []int64) error {
+	return l.DeleteImportListsContext(context.Background(), ids)
+}
+
+// DeleteImportListsContext removes many import lists at once using the bulk editor.
+func (l *Lidarr) DeleteImportListsContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpImportListExclusion = APIver + "/importlistexclusion"
+
+// ImportListExclusion is a Lidarr excluded artist. Import lists never add these.
+type ImportListExclusion struct {
+	ID         int64  `json:"id,omitempty"`
+	ForeignID  string `json:"foreignId"`
+	ArtistName string `json:"artistName"`
+}
+
+// ImportListExclusionPage is a single page of import list exclusions.
+type ImportListExclusionPage struct {
+	Page          int                    `json:"page"`
+	PageSize      int                    `json:"pageSize"`
+	SortKey       string                 `json:"sortKey"`
+	SortDirection string                 `json:"sortDirection"`
+	TotalRecords  int                    `json:"totalRecords"`
+	Records       []*ImportListExclusion `json:"records"`
+}
+
+// GetImportListExclusions returns all import list exclusions.
+func (l *Lidarr) GetImportListExclusions() ([]*ImportListExclusion, error) {
+	return l.GetImportListExclusionsContext(context.Background())
+}
+
+// GetImportListExclusionsContext returns all import list exclusions.
+func (l *Lidarr) GetImportListExclusionsContext(ctx context.Context) ([]*ImportListExclusion, error) {
+	var output []*ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetImportListExclusionsPage returns a single page of import list exclusions.
+// The page size and number is configurable with the input request parameters. Sorts by id by default.
+func (l *Lidarr) GetImportListExclusionsPage(params *starr.PageReq) (*ImportListExclusionPage, error) {
+	return l.GetImportListExclusionsPageContext(context.Background(), params)
+}
+
+// GetImportListExclusionsPageContext returns a single page of import list exclusions.
+// The page size and number is configurable with the input request parameters. Sorts by id by default.
+func (l *Lidarr) GetImportListExclusionsPageContext(
+	ctx context.Context,
+	params *starr.PageReq,
+) (*ImportListExclusionPage, error) {
+	var output ImportListExclusionPage
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, "paged"), Query: params.Params()}
+	if params.SortKey == "" {
+		req.Query.Set("sortKey", "id") // exclusions have no date.
+	}
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListExclusion returns a single import list exclusion.
+func (l *Lidarr) GetImportListExclusion(exclusionID int64) (*ImportListExclusion, error) {
+	return l.GetImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// GetImportListExclusionContext returns a single import list exclusion.
+func (l *Lidarr) GetImportListExclusionContext(ctx context.Context, exclusionID int64) (*ImportListExclusion, error) {
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddImportListExclusion creates an import list exclusion.
+func (l *Lidarr) AddImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return l.AddImportListExclusionContext(context.Background(), exclusion)
+}
+
+// AddImportListExclusionContext creates an import list exclusion.
+func (l *Lidarr) AddImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	input := *exclusion
+	input.ID = 0
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateImportListExclusion updates an import list exclusion.
+func (l *Lidarr) UpdateImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return l.UpdateImportListExclusionContext(context.Background(), exclusion)
+}
+
+// UpdateImportListExclusionContext updates an import list exclusion.
+func (l *Lidarr) UpdateImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(exclusion); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusion.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteImportListExclusion removes a single import list exclusion.
+func (l *Lidarr) DeleteImportListExclusion(exclusionID int64) error {
+	return l.DeleteImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// DeleteImportListExclusionContext removes a single import list exclusion.
+func (l *Lidarr) DeleteImportListExclusionContext(ctx context.Context, exclusionID int64) error {
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteImportListExclusions removes many import list exclusions.
+func (l *Lidarr) DeleteImportListExclusions(exclusionIDs []int64) error {
+	return l.DeleteImportListExclusionsContext(context.Background(), exclusionIDs)
+}
+
+// DeleteImportListExclusionsContext removes many import list exclusions.
+func (l *Lidarr) DeleteImportListExclusionsContext(ctx context.Context, exclusionIDs []int64) error {
+	var errs string
+
+	for _, exclusionID := range exclusionIDs {
+		if err := l.DeleteImportListExclusionContext(ctx, exclusionID); err != nil {
+			errs += err.Error() + " "
+		}
+	}
+
+	if errs != "" {
+		return fmt.Errorf("%w: %s", starr.ErrRequestError, errs)
+	}
+
+	return nil
+}
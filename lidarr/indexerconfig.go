@@ -21,6 +21,16 @@ type IndexerConfig struct {
 	RssSyncInterval int64 `json:"rssSyncInterval"`
 }
 
+// IndexerConfigPatch contains the indexer config fields to change with PatchIndexerConfig.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type IndexerConfigPatch struct {
+	MaximumSize     *int64 `json:"maximumSize,omitempty"`
+	MinimumAge      *int64 `json:"minimumAge,omitempty"`
+	Retention       *int64 `json:"retention,omitempty"`
+	RssSyncInterval *int64 `json:"rssSyncInterval,omitempty"`
+}
+
 // GetIndexerConfig returns an Indexer Config.
 func (l *Lidarr) GetIndexerConfig() (*IndexerConfig, error) {
 	return l.GetIndexerConfigContext(context.Background())
@@ -59,3 +69,21 @@ func (l *Lidarr) UpdateIndexerConfigContext(ctx context.Context, indexerConfig *
 
 	return &output, nil
 }
+
+// PatchIndexerConfig changes only the non-nil fields in patch, and leaves the rest of the indexer config alone.
+// This fetches the current indexer config, merges the patch into it, and updates it.
+func (l *Lidarr) PatchIndexerConfig(patch *IndexerConfigPatch) (*IndexerConfig, error) {
+	return l.PatchIndexerConfigContext(context.Background(), patch)
+}
+
+// PatchIndexerConfigContext changes only the non-nil fields in patch, and leaves the rest of the indexer config alone.
+// This fetches the current indexer config, merges the patch into it, and updates it.
+func (l *Lidarr) PatchIndexerConfigContext(ctx context.Context, patch *IndexerConfigPatch) (*IndexerConfig, error) {
+	var output IndexerConfig
+
+	if err := starr.PatchConfig(ctx, l, bpIndexerConfig, true, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,207 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for Naming calls.
+const bpNaming = APIver + "/config/naming"
+
+// Naming represents the config/naming endpoint in Lidarr.
+type Naming struct {
+	RenameTracks             bool   `json:"renameTracks"`
+	ReplaceIllegalCharacters bool   `json:"replaceIllegalCharacters"`
+	IncludeArtistName        bool   `json:"includeArtistName"`
+	IncludeAlbumTitle        bool   `json:"includeAlbumTitle"`
+	IncludeQuality           bool   `json:"includeQuality"`
+	ReplaceSpaces            bool   `json:"replaceSpaces"`
+	ID                       int64  `json:"id"`
+	ColonReplacementFormat   int64  `json:"colonReplacementFormat"`
+	StandardTrackFormat      string `json:"standardTrackFormat"`
+	MultiDiscTrackFormat     string `json:"multiDiscTrackFormat"`
+	ArtistFolderFormat       string `json:"artistFolderFormat"`
+	Separator                string `json:"separator"`
+	NumberStyle              string `json:"numberStyle"`
+}
+
+// NamingPatch contains the naming config fields to change with PatchNaming.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type NamingPatch struct {
+	RenameTracks             *bool   `json:"renameTracks,omitempty"`
+	ReplaceIllegalCharacters *bool   `json:"replaceIllegalCharacters,omitempty"`
+	IncludeArtistName        *bool   `json:"includeArtistName,omitempty"`
+	IncludeAlbumTitle        *bool   `json:"includeAlbumTitle,omitempty"`
+	IncludeQuality           *bool   `json:"includeQuality,omitempty"`
+	ReplaceSpaces            *bool   `json:"replaceSpaces,omitempty"`
+	ColonReplacementFormat   *int64  `json:"colonReplacementFormat,omitempty"`
+	StandardTrackFormat      *string `json:"standardTrackFormat,omitempty"`
+	MultiDiscTrackFormat     *string `json:"multiDiscTrackFormat,omitempty"`
+	ArtistFolderFormat       *string `json:"artistFolderFormat,omitempty"`
+	Separator                *string `json:"separator,omitempty"`
+	NumberStyle              *string `json:"numberStyle,omitempty"`
+}
+
+// GetNaming returns the file naming rules.
+func (l *Lidarr) GetNaming() (*Naming, error) {
+	return l.GetNamingContext(context.Background())
+}
+
+// GetNamingContext returns the file naming rules.
+func (l *Lidarr) GetNamingContext(ctx context.Context) (*Naming, error) {
+	var output Naming
+
+	req := starr.Request{URI: bpNaming}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateNaming updates the file naming rules.
+func (l *Lidarr) UpdateNaming(naming *Naming) (*Naming, error) {
+	return l.UpdateNamingContext(context.Background(), naming)
+}
+
+// UpdateNamingContext updates the file naming rules.
+func (l *Lidarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Naming, error) {
+	var output Naming
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(naming); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpNaming, err)
+	}
+
+	req := starr.Request{URI: bpNaming, Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PatchNaming changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (l *Lidarr) PatchNaming(patch *NamingPatch) (*Naming, error) {
+	return l.PatchNamingContext(context.Background(), patch)
+}
+
+// PatchNamingContext changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (l *Lidarr) PatchNamingContext(ctx context.Context, patch *NamingPatch) (*Naming, error) {
+	var output Naming
+
+	if err := starr.PatchConfig(ctx, l, bpNaming, false, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
+
+// NamingExamples contains the sample file and folder names rendered from a naming config.
+type NamingExamples struct {
+	SingleTrackExample    string `json:"singleTrackExample"`
+	MultiDiscTrackExample string `json:"multiDiscTrackExample"`
+	ArtistFolderExample   string `json:"artistFolderExample"`
+}
+
+// namingTokens are the tokens Lidarr accepts in naming formats. Some take a :format, like {Release Year:0000}.
+//
+//nolint:gochecknoglobals
+var namingTokens = []string{
+	"Artist Name",
+	"Artist CleanName",
+	"Artist NameThe",
+	"Artist CleanNameThe",
+	"Artist NameFirstCharacter",
+	"Artist Disambiguation",
+	"Artist Genre",
+	"Artist MbId",
+	"Album Title",
+	"Album CleanTitle",
+	"Album TitleThe",
+	"Album CleanTitleThe",
+	"Album Type",
+	"Album Disambiguation",
+	"Album Genre",
+	"Album MbId",
+	"Release Year",
+	"medium",
+	"medium format",
+	"track",
+	"Track Title",
+	"Track CleanTitle",
+	"Track ArtistName",
+	"Track ArtistCleanName",
+	"Track ArtistNameThe",
+	"Track ArtistCleanNameThe",
+	"Track ArtistMbId",
+	"Quality Proper",
+	"Quality Real",
+	"MediaInfo AudioCodec",
+	"MediaInfo AudioChannels",
+	"MediaInfo AudioBitRate",
+	"MediaInfo AudioBitsPerSample",
+	"MediaInfo AudioSampleRate",
+	"Preferred Words",
+	"Quality Full",
+	"Quality Title",
+	"Release Group",
+	"Custom Formats",
+	"Custom Format",
+	"Original Title",
+	"Original Filename",
+}
+
+// GetNamingExamples renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (l *Lidarr) GetNamingExamples(naming *Naming) (*NamingExamples, error) {
+	return l.GetNamingExamplesContext(context.Background(), naming)
+}
+
+// GetNamingExamplesContext renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (l *Lidarr) GetNamingExamplesContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	query, err := starr.NamingQuery(naming)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped.
+	}
+
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: query}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// ValidateNamingFormat returns starr.ErrUnknownToken if a naming format contains a token Lidarr does not support.
+// This check is local; it does not contact Lidarr. An input like "{Artist Name} - {track:00} - {Track Title}" is valid.
+func ValidateNamingFormat(format string) error {
+	return starr.ValidateNamingFormat(format, namingTokens) //nolint:wrapcheck // the error is already wrapped.
+}
+
+// Validate checks every format in the naming config for unknown tokens. Empty formats are skipped.
+// Returns an error wrapping starr.ErrUnknownToken that names the first invalid format.
+func (n *Naming) Validate() error {
+	for _, format := range []struct{ name, format string }{
+		{"StandardTrackFormat", n.StandardTrackFormat},
+		{"MultiDiscTrackFormat", n.MultiDiscTrackFormat},
+		{"ArtistFolderFormat", n.ArtistFolderFormat},
+	} {
+		if err := ValidateNamingFormat(format.format); err != nil {
+			return fmt.Errorf("%s: %w", format.name, err)
+		}
+	}
+
+	return nil
+}
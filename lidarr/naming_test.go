@@ -0,0 +1,28 @@
+package lidarr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+)
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{
+		// The Lidarr defaults.
+		"{Album Title} ({Release Year})/{Artist Name} - {Album Title} - {track:00} - {Track Title}",
+		`{Album Title} ({Release Year})/{Medium Format} {medium:00}/{Artist Name}` +
+			` - {Album Title} - {track:00} - {Track Title}`,
+		"{Artist Name}",
+		// A few other real formats.
+		`{Artist CleanName} - {Album Type} - {Release Year} - {Album CleanTitle}` +
+			`/{track:00} - {Track ArtistName} - {Track CleanTitle} {[Quality Title]}`,
+	} {
+		assert.NoError(t, lidarr.ValidateNamingFormat(format), format)
+	}
+
+	assert.ErrorIs(t, lidarr.ValidateNamingFormat("{Release Year} {Release Yaer}"), starr.ErrUnknownToken)
+}
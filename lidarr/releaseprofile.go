@@ -0,0 +1,119 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for Release Profile calls.
+const bpReleaseProfile = APIver + "/releaseprofile"
+
+// ReleaseProfile defines a release profile's data from Lidarr.
+type ReleaseProfile struct {
+	Enabled         bool              `json:"enabled"`
+	Required        []string          `json:"required"`
+	Ignored         []string          `json:"ignored"`
+	IndexerID       int64             `json:"indexerId"`
+	Tags            []int             `json:"tags"`
+	ID              int64             `json:"id,omitempty"`
+	IncPrefOnRename bool              `json:"includePreferredWhenRenaming"`
+	Preferred       []*starr.KeyValue `json:"preferred"`
+}
+
+// GetReleaseProfiles returns all configured release profiles.
+func (l *Lidarr) GetReleaseProfiles() ([]*ReleaseProfile, error) {
+	return l.GetReleaseProfilesContext(context.Background())
+}
+
+// GetReleaseProfilesContext returns all configured release profiles.
+func (l *Lidarr) GetReleaseProfilesContext(ctx context.Context) ([]*ReleaseProfile, error) {
+	var output []*ReleaseProfile
+
+	req := starr.Request{URI: bpReleaseProfile}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetReleaseProfile returns a single release profile.
+func (l *Lidarr) GetReleaseProfile(profileID int64) (*ReleaseProfile, error) {
+	return l.GetReleaseProfileContext(context.Background(), profileID)
+}
+
+// GetReleaseProfileContext returns a single release profile.
+func (l *Lidarr) GetReleaseProfileContext(ctx context.Context, profileID int64) (*ReleaseProfile, error) {
+	var output ReleaseProfile
+
+	req := starr.Request{URI: path.Join(bpReleaseProfile, fmt.Sprint(profileID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddReleaseProfile creates a release profile.
+func (l *Lidarr) AddReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error) {
+	return l.AddReleaseProfileContext(context.Background(), profile)
+}
+
+// AddReleaseProfileContext creates a release profile.
+func (l *Lidarr) AddReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error) {
+	var output ReleaseProfile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleaseProfile, err)
+	}
+
+	req := starr.Request{URI: bpReleaseProfile, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateReleaseProfile updates the release profile.
+func (l *Lidarr) UpdateReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error) {
+	return l.UpdateReleaseProfileContext(context.Background(), profile)
+}
+
+// UpdateReleaseProfileContext updates the release profile.
+func (l *Lidarr) UpdateReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error) {
+	var output ReleaseProfile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleaseProfile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpReleaseProfile, fmt.Sprint(profile.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteReleaseProfile removes a single release profile.
+func (l *Lidarr) DeleteReleaseProfile(profileID int64) error {
+	return l.DeleteReleaseProfileContext(context.Background(), profileID)
+}
+
+// DeleteReleaseProfileContext removes a single release profile.
+func (l *Lidarr) DeleteReleaseProfileContext(ctx context.Context, profileID int64) error {
+	req := starr.Request{URI: path.Join(bpReleaseProfile, fmt.Sprint(profileID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is a file that does not match the naming config, from the /api/v1/rename endpoint.
+type Rename struct {
+	ArtistID     int64   `json:"artistId"`
+	AlbumID      int64   `json:"albumId"`
+	TrackNumbers []int64 `json:"trackNumbers"`
+	TrackFileID  int64   `json:"trackFileId"`
+	ExistingPath string  `json:"existingPath"`
+	NewPath      string  `json:"newPath"`
+}
+
+// GetRename returns the existing and new path for each artist file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (l *Lidarr) GetRename(artistID int64) ([]*Rename, error) {
+	return l.GetRenameContext(context.Background(), artistID)
+}
+
+// GetRenameContext returns the existing and new path for each artist file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (l *Lidarr) GetRenameContext(ctx context.Context, artistID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Add("artistId", fmt.Sprint(artistID))
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RenameFiles renames files that belong to a artist, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (l *Lidarr) RenameFiles(artistID int64, fileIDs []int64) (*CommandResponse, error) {
+	return l.RenameFilesContext(context.Background(), artistID, fileIDs)
+}
+
+// RenameFilesContext renames files that belong to a artist, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+// Cancel the context to stop waiting; the command keeps running in Lidarr.
+func (l *Lidarr) RenameFilesContext(ctx context.Context, artistID int64, fileIDs []int64) (*CommandResponse, error) {
+	cmd, err := l.SendCommandContext(ctx, &CommandRequest{Name: "RenameFiles", ArtistID: artistID, Files: fileIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.WaitForCommandContext(ctx, cmd, starr.DefaultCommandInterval)
+}
@@ -1,8 +1,11 @@
 package lidarr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
@@ -10,12 +13,20 @@ import (
 const bpRootFolder = APIver + "/rootFolder"
 
 // RootFolder is the /api/v1/rootfolder endpoint.
+// Name, Path and the default profile IDs are required to add a root folder.
 type RootFolder struct {
-	ID              int64         `json:"id"`
-	Path            string        `json:"path"`
-	FreeSpace       int64         `json:"freeSpace"`
-	TotalSpace      int64         `json:"totalSpace"`
-	UnmappedFolders []*starr.Path `json:"unmappedFolders"`
+	ID                          int64         `json:"id,omitempty"`
+	Name                        string        `json:"name,omitempty"`
+	Path                        string        `json:"path"`
+	DefaultMetadataProfileID    int64         `json:"defaultMetadataProfileId,omitempty"`
+	DefaultQualityProfileID     int64         `json:"defaultQualityProfileId,omitempty"`
+	DefaultMonitorOption        string        `json:"defaultMonitorOption,omitempty"`
+	DefaultNewItemMonitorOption string        `json:"defaultNewItemMonitorOption,omitempty"`
+	DefaultTags                 []int         `json:"defaultTags,omitempty"`
+	Accessible                  bool          `json:"accessible,omitempty"`
+	FreeSpace                   int64         `json:"freeSpace,omitempty"`
+	TotalSpace                  int64         `json:"totalSpace,omitempty"`
+	UnmappedFolders             []*starr.Path `json:"unmappedFolders,omitempty"`
 }
 
 // GetRootFolders returns all configured root folders.
@@ -34,3 +45,79 @@ func (l *Lidarr) GetRootFoldersContext(ctx context.Context) ([]*RootFolder, erro
 
 	return output, nil
 }
+
+// GetRootFolder returns a single root folder.
+func (l *Lidarr) GetRootFolder(folderID int64) (*RootFolder, error) {
+	return l.GetRootFolderContext(context.Background(), folderID)
+}
+
+// GetRootFolderContext returns a single root folder.
+func (l *Lidarr) GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error) {
+	var output RootFolder
+
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folderID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddRootFolder creates a root folder.
+func (l *Lidarr) AddRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return l.AddRootFolderContext(context.Background(), folder)
+}
+
+// AddRootFolderContext creates a root folder.
+func (l *Lidarr) AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: bpRootFolder, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateRootFolder updates a root folder's name and defaults.
+func (l *Lidarr) UpdateRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return l.UpdateRootFolderContext(context.Background(), folder)
+}
+
+// UpdateRootFolderContext updates a root folder's name and defaults.
+func (l *Lidarr) UpdateRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folder.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteRootFolder removes a single root folder.
+func (l *Lidarr) DeleteRootFolder(folderID int64) error {
+	return l.DeleteRootFolderContext(context.Background(), folderID)
+}
+
+// DeleteRootFolderContext removes a single root folder.
+func (l *Lidarr) DeleteRootFolderContext(ctx context.Context, folderID int64) error {
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folderID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
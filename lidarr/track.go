@@ -0,0 +1,123 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpTrack = APIver + "/track"
+
+// Track is the /api/v1/track endpoint.
+type Track struct {
+	ID                  int64          `json:"id"`
+	ArtistID            int64          `json:"artistId"`
+	AlbumID             int64          `json:"albumId"`
+	ForeignTrackID      string         `json:"foreignTrackId"`
+	ForeignRecordingID  string         `json:"foreignRecordingId"`
+	TrackFileID         int64          `json:"trackFileId"`
+	Explicit            bool           `json:"explicit"`
+	AbsoluteTrackNumber int            `json:"absoluteTrackNumber"`
+	TrackNumber         string         `json:"trackNumber"`
+	Title               string         `json:"title"`
+	Duration            int            `json:"duration"`
+	MediumNumber        int            `json:"mediumNumber"`
+	HasFile             bool           `json:"hasFile"`
+	Ratings             *starr.Ratings `json:"ratings,omitempty"`
+	TrackFile           *TrackFile     `json:"trackFile,omitempty"`
+	Artist              *Artist        `json:"artist,omitempty"`
+	Grabbed             bool           `json:"grabbed"`
+}
+
+// GetTracksForArtist returns the tracks for an artist.
+func (l *Lidarr) GetTracksForArtist(artistID int64) ([]*Track, error) {
+	return l.GetTracksForArtistContext(context.Background(), artistID)
+}
+
+// GetTracksForArtistContext returns the tracks for an artist.
+func (l *Lidarr) GetTracksForArtistContext(ctx context.Context, artistID int64) ([]*Track, error) {
+	return l.getTracks(ctx, "artistId", artistID)
+}
+
+// GetTracksForAlbum returns the tracks for an album.
+func (l *Lidarr) GetTracksForAlbum(albumID int64) ([]*Track, error) {
+	return l.GetTracksForAlbumContext(context.Background(), albumID)
+}
+
+// GetTracksForAlbumContext returns the tracks for an album.
+func (l *Lidarr) GetTracksForAlbumContext(ctx context.Context, albumID int64) ([]*Track, error) {
+	return l.getTracks(ctx, "albumId", albumID)
+}
+
+// GetTracksForRelease returns the tracks for an album release. Use the release's (database) ID, not the foreign ID.
+func (l *Lidarr) GetTracksForRelease(releaseID int64) ([]*Track, error) {
+	return l.GetTracksForReleaseContext(context.Background(), releaseID)
+}
+
+// GetTracksForReleaseContext returns the tracks for an album release.
+// Use the release's (database) ID, not the foreign ID.
+func (l *Lidarr) GetTracksForReleaseContext(ctx context.Context, releaseID int64) ([]*Track, error) {
+	return l.getTracks(ctx, "albumReleaseId", releaseID)
+}
+
+func (l *Lidarr) getTracks(ctx context.Context, param string, itemID int64) ([]*Track, error) {
+	var output []*Track
+
+	req := starr.Request{URI: bpTrack, Query: make(url.Values)}
+	req.Query.Add(param, fmt.Sprint(itemID))
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetTracks returns the requested tracks by ID.
+func (l *Lidarr) GetTracks(trackIDs []int64) ([]*Track, error) {
+	return l.GetTracksContext(context.Background(), trackIDs)
+}
+
+// GetTracksContext returns the requested tracks by their IDs.
+func (l *Lidarr) GetTracksContext(ctx context.Context, trackIDs []int64) ([]*Track, error) {
+	var output []*Track
+
+	if len(trackIDs) == 0 {
+		return output, nil
+	}
+
+	req := starr.Request{
+		URI:   bpTrack,
+		Query: url.Values{"trackIds": make([]string, len(trackIDs))},
+	}
+
+	for idx, trackID := range trackIDs {
+		req.Query["trackIds"][idx] = fmt.Sprint(trackID)
+	}
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetTrack returns a single track by its ID.
+func (l *Lidarr) GetTrack(trackID int64) (*Track, error) {
+	return l.GetTrackContext(context.Background(), trackID)
+}
+
+// GetTrackContext returns a single track by its ID.
+func (l *Lidarr) GetTrackContext(ctx context.Context, trackID int64) (*Track, error) {
+	var output Track
+
+	req := starr.Request{URI: path.Join(bpTrack, fmt.Sprint(trackID))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,140 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+/* This file contains the optional client-side request limits for a Config.
+ * Small *arr instances (especially on SQLite) stall when a library user fans out many requests.
+ */
+
+// limits holds the optional rate limiter and concurrency semaphore for a Config.
+type limits struct {
+	rate *rateLimiter
+	sem  chan struct{}
+}
+
+// rateLimiter is a token bucket. Tokens refill at rate per second, up to burst.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// SetRateLimit limits the requests this Config makes to perSecond, allowing bursts of up to burst requests.
+// A burst less than 1 is treated as 1. Pass 0 for perSecond to remove the rate limit.
+// Requests wait for a token, or until their context is cancelled. Call this before making requests.
+func (c *Config) SetRateLimit(perSecond float64, burst int) {
+	if c.limits == nil {
+		c.limits = &limits{}
+	}
+
+	if perSecond <= 0 {
+		c.limits.rate = nil
+		return
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	c.limits.rate = &rateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// SetMaxInFlight limits the number of concurrent requests this Config makes.
+// A request is in flight until its response body is closed. Pass 0 to remove the limit.
+// Requests wait for a free slot, or until their context is cancelled. Call this before making requests.
+func (c *Config) SetMaxInFlight(maxInFlight int) {
+	if c.limits == nil {
+		c.limits = &limits{}
+	}
+
+	if maxInFlight <= 0 {
+		c.limits.sem = nil
+		return
+	}
+
+	c.limits.sem = make(chan struct{}, maxInFlight)
+}
+
+// wait blocks until a request may be sent. The returned function must be called when the request is finished.
+func (l *limits) wait(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+
+	if sem := l.sem; sem != nil {
+		select {
+		case sem <- struct{}{}:
+			release = sync.OnceFunc(func() { <-sem })
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for a request slot: %w", ctx.Err())
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// wait takes a token from the bucket, and sleeps until the token is available.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	r.mu.Lock()
+
+	now := time.Now()
+	r.tokens = math.Min(r.burst, r.tokens+now.Sub(r.last).Seconds()*r.rate)
+	r.last = now
+	r.tokens-- // reserve a token, even if we have to wait for it.
+	delay := time.Duration(-r.tokens / r.rate * float64(time.Second))
+
+	r.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.mu.Lock()
+		r.tokens++ // give back the reserved token.
+		r.mu.Unlock()
+
+		return fmt.Errorf("waiting for the rate limiter: %w", ctx.Err())
+	}
+}
+
+// releaseBody frees a request slot when the response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the response body and frees the request slot.
+func (r *releaseBody) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close() //nolint:wrapcheck // this is a pass-through.
+}
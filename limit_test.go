@@ -0,0 +1,84 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestSetMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, most int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		now := atomic.AddInt64(&inFlight, 1)
+		defer atomic.AddInt64(&inFlight, -1)
+
+		for old := atomic.LoadInt64(&most); now > old; old = atomic.LoadInt64(&most) {
+			if atomic.CompareAndSwapInt64(&most, old, now) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.SetMaxInFlight(2)
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var output interface{}
+			assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "v3/test"}, &output))
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int64(2), atomic.LoadInt64(&most), "no more than 2 requests may run at once")
+}
+
+func TestSetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.SetRateLimit(20, 2)
+
+	var output interface{}
+
+	start := time.Now()
+
+	for range 4 { // 2 are free (burst), 2 more wait 50ms each.
+		require.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "v3/test"}, &output))
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// A cancelled context does not wait for a token.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := config.GetInto(ctx, starr.Request{URI: "v3/test"}, &output)
+	require.ErrorIs(t, err, context.Canceled)
+}
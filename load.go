@@ -0,0 +1,225 @@
+package starr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+/* This file contains loaders that build a Config from the environment, a Servarr config.xml, or a JSON/YAML file.
+ * Containers can point the loaders at the app's config volume, and never store the API key themselves.
+ */
+
+// ErrInvalidConfig is returned by the loaders when a Config is missing its URL or API key.
+var ErrInvalidConfig = fmt.Errorf("invalid config")
+
+// ServarrConfig is the data this library reads from a Servarr config.xml file.
+// Every *arr app writes this file to its config (app data) folder.
+type ServarrConfig struct {
+	APIKey      string `xml:"ApiKey"`
+	BindAddress string `xml:"BindAddress"`
+	Port        int    `xml:"Port"`
+	SslPort     int    `xml:"SslPort"`
+	EnableSsl   bool   `xml:"EnableSsl"`
+	URLBase     string `xml:"UrlBase"`
+}
+
+// configEntry is one Config in a JSON/YAML document, or from the environment.
+// APIKeyFile and ConfigFile are alternatives to putting the API key in the document.
+type configEntry struct {
+	Config     `yaml:",inline"`
+	APIKeyFile string `json:"apiKeyFile" yaml:"apiKeyFile"`
+	ConfigFile string `json:"configFile" yaml:"configFile"`
+}
+
+// ParseServarrConfig reads a Servarr config.xml, and returns a Config with the API key and URL from the file.
+// The URL uses host, or the bind address from the file if host is empty. A wildcard bind address becomes localhost.
+// The URL uses https and the SSL port when SSL is enabled.
+func ParseServarrConfig(input io.Reader, host string) (*Config, error) {
+	var servarr ServarrConfig
+	if err := xml.NewDecoder(input).Decode(&servarr); err != nil {
+		return nil, fmt.Errorf("decoding servarr config: %w", err)
+	}
+
+	return servarr.Config(host), nil
+}
+
+// Config returns a Config with the API key and URL from a Servarr config.xml.
+// See ParseServarrConfig for how the URL is built.
+func (s *ServarrConfig) Config(host string) *Config {
+	if host == "" {
+		host = s.BindAddress
+	}
+
+	if host == "" || host == "*" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+
+	scheme, port := "http", s.Port
+	if s.EnableSsl {
+		scheme, port = "https", s.SslPort
+	}
+
+	appURL := url.URL{Scheme: scheme, Host: host, Path: "/"}
+	if port != 0 {
+		appURL.Host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+
+	if base := strings.Trim(s.URLBase, "/"); base != "" {
+		appURL.Path = path.Join("/", base) + "/"
+	}
+
+	return New(s.APIKey, appURL.String(), 0)
+}
+
+// LoadServarrConfig opens a Servarr config.xml file, and returns a Config with the API key and URL from the file.
+// See ParseServarrConfig for how the URL is built.
+func LoadServarrConfig(filePath, host string) (*Config, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("opening servarr config: %w", err)
+	}
+	defer file.Close()
+
+	return ParseServarrConfig(file, host)
+}
+
+// ConfigsFromEnv returns a Config for each app that has environment variables.
+// The map is keyed by the lower-case app name. If no apps are provided, every app except Emby and Plex is checked.
+// The variables for Sonarr are below; other apps use their own upper-case name as the prefix.
+// SONARR_API_KEY_FILE is a file that contains only the API key.
+// SONARR_CONFIG_FILE is the path to Sonarr's config.xml, and provides the API key and URL if they are not set.
+//
+//	SONARR_URL, SONARR_API_KEY, SONARR_API_KEY_FILE, SONARR_CONFIG_FILE,
+//	SONARR_HTTP_USER, SONARR_HTTP_PASS, SONARR_USERNAME, SONARR_PASSWORD
+func ConfigsFromEnv(apps ...App) (map[string]*Config, error) {
+	if len(apps) == 0 {
+		apps = []App{Lidarr, Prowlarr, Radarr, Readarr, Sonarr}
+	}
+
+	configs := make(map[string]*Config)
+
+	for _, app := range apps {
+		prefix := strings.ToUpper(app.String()) + "_"
+		entry := &configEntry{
+			Config: Config{
+				URL:      os.Getenv(prefix + "URL"),
+				APIKey:   os.Getenv(prefix + "API_KEY"),
+				HTTPUser: os.Getenv(prefix + "HTTP_USER"),
+				HTTPPass: os.Getenv(prefix + "HTTP_PASS"),
+				Username: os.Getenv(prefix + "USERNAME"),
+				Password: os.Getenv(prefix + "PASSWORD"),
+			},
+			APIKeyFile: os.Getenv(prefix + "API_KEY_FILE"),
+			ConfigFile: os.Getenv(prefix + "CONFIG_FILE"),
+		}
+
+		if entry.URL == "" && entry.APIKey == "" && entry.APIKeyFile == "" && entry.ConfigFile == "" {
+			continue
+		}
+
+		config, err := entry.build(prefix + "*")
+		if err != nil {
+			return nil, err
+		}
+
+		configs[app.Lower()] = config
+	}
+
+	return configs, nil
+}
+
+// ParseConfigs decodes a JSON or YAML document that contains named Configs, and returns them keyed by name.
+// Each Config may provide apiKeyFile or configFile (a Servarr config.xml) instead of apiKey.
+// The URL from configFile is used when url is empty. A YAML example:
+//
+//	sonarr:
+//	  url: http://sonarr:8989
+//	  configFile: /config/sonarr/config.xml
+//	sonarr4k:
+//	  url: http://sonarr4k:8989
+//	  apiKeyFile: /run/secrets/sonarr4k
+func ParseConfigs(data []byte) (map[string]*Config, error) {
+	entries := make(map[string]*configEntry)
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("decoding configs: %w", err)
+	}
+
+	configs := make(map[string]*Config, len(entries))
+
+	for name, entry := range entries {
+		if entry == nil {
+			return nil, fmt.Errorf("%w: %s: empty config", ErrInvalidConfig, name)
+		}
+
+		config, err := entry.build(name)
+		if err != nil {
+			return nil, err
+		}
+
+		configs[name] = config
+	}
+
+	return configs, nil
+}
+
+// LoadConfigs reads a JSON or YAML file that contains named Configs. See ParseConfigs for the format.
+func LoadConfigs(filePath string) (map[string]*Config, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading configs: %w", err)
+	}
+
+	return ParseConfigs(data)
+}
+
+// build fills in the API key and URL from the key file and config.xml, and returns a new Config.
+// An API key in the entry wins over the key file, and the key file wins over config.xml.
+func (e *configEntry) build(name string) (*Config, error) {
+	if e.APIKey == "" && e.APIKeyFile != "" {
+		key, err := os.ReadFile(e.APIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: reading API key file: %w", name, err)
+		}
+
+		e.APIKey = strings.TrimSpace(string(key))
+	}
+
+	if e.ConfigFile != "" && (e.APIKey == "" || e.URL == "") {
+		servarr, err := LoadServarrConfig(e.ConfigFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if e.APIKey == "" {
+			e.APIKey = servarr.APIKey
+		}
+
+		if e.URL == "" {
+			e.URL = servarr.URL
+		}
+	}
+
+	switch {
+	case e.URL == "":
+		return nil, fmt.Errorf("%w: %s: missing url", ErrInvalidConfig, name)
+	case e.APIKey == "":
+		return nil, fmt.Errorf("%w: %s: missing API key", ErrInvalidConfig, name)
+	}
+
+	config := New(e.APIKey, e.URL, 0)
+	config.HTTPUser = e.HTTPUser
+	config.HTTPPass = e.HTTPPass
+	config.Username = e.Username
+	config.Password = e.Password
+
+	return config, nil
+}
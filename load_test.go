@@ -0,0 +1,93 @@
+package starr_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+const servarrConfigXML = `<Config>
+  <BindAddress>*</BindAddress>
+  <Port>8989</Port>
+  <SslPort>9898</SslPort>
+  <EnableSsl>False</EnableSsl>
+  <LaunchBrowser>True</LaunchBrowser>
+  <ApiKey>0123456789abcdef</ApiKey>
+  <AuthenticationMethod>Forms</AuthenticationMethod>
+  <UrlBase>/sonarr</UrlBase>
+</Config>`
+
+func TestParseServarrConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := starr.ParseServarrConfig(strings.NewReader(servarrConfigXML), "")
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", config.APIKey)
+	assert.Equal(t, "http://localhost:8989/sonarr/", config.URL)
+
+	sslConfig := strings.Replace(servarrConfigXML, "<EnableSsl>False", "<EnableSsl>True", 1)
+	config, err = starr.ParseServarrConfig(strings.NewReader(sslConfig), "sonarr")
+	require.NoError(t, err)
+	assert.Equal(t, "https://sonarr:9898/sonarr/", config.URL)
+}
+
+func TestParseConfigs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	xmlFile := filepath.Join(dir, "config.xml")
+	keyFile := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(xmlFile, []byte(servarrConfigXML), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, []byte("secretkey\n"), 0o600))
+
+	configs, err := starr.ParseConfigs([]byte(`
+sonarr:
+  url: http://sonarr:8989/sonarr
+  configFile: ` + xmlFile + `
+radarr:
+  url: http://radarr:7878
+  apiKeyFile: ` + keyFile + `
+  httpUser: admin
+`))
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, "http://sonarr:8989/sonarr", configs["sonarr"].URL)
+	assert.Equal(t, "0123456789abcdef", configs["sonarr"].APIKey)
+	assert.Equal(t, "secretkey", configs["radarr"].APIKey)
+	assert.Equal(t, "admin", configs["radarr"].HTTPUser)
+	assert.NotNil(t, configs["radarr"].Client)
+
+	configs, err = starr.ParseConfigs([]byte(`{"lidarr": {"url": "http://lidarr:8686", "apiKey": "abc"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "abc", configs["lidarr"].APIKey)
+
+	_, err = starr.ParseConfigs([]byte(`{"lidarr": {"apiKey": "abc"}}`))
+	require.ErrorIs(t, err, starr.ErrInvalidConfig)
+}
+
+func TestConfigsFromEnv(t *testing.T) { //nolint:paralleltest // this test sets environment variables.
+	xmlFile := filepath.Join(t.TempDir(), "config.xml")
+	require.NoError(t, os.WriteFile(xmlFile, []byte(servarrConfigXML), 0o600))
+
+	t.Setenv("SONARR_CONFIG_FILE", xmlFile)
+	t.Setenv("READARR_URL", "http://readarr:8787")
+	t.Setenv("READARR_API_KEY", "readarrkey")
+	t.Setenv("RADARR_URL", "")
+
+	configs, err := starr.ConfigsFromEnv(starr.Sonarr, starr.Readarr, starr.Radarr)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, "http://localhost:8989/sonarr/", configs["sonarr"].URL)
+	assert.Equal(t, "0123456789abcdef", configs["sonarr"].APIKey)
+	assert.Equal(t, "readarrkey", configs["readarr"].APIKey)
+
+	t.Setenv("RADARR_URL", "http://radarr:7878")
+
+	_, err = starr.ConfigsFromEnv(starr.Radarr)
+	require.ErrorIs(t, err, starr.ErrInvalidConfig)
+}
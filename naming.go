@@ -0,0 +1,105 @@
+package starr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+/* This file contains helpers for the naming config in each app.
+ * The apps accept tokens like {Series Title}, {Series.Title} and {season:00}.
+ * Spaces, dots, dashes and underscores in a token are interchangeable, and case only changes the output.
+ */
+
+// ErrUnknownToken is returned when a naming format contains a token the app does not support.
+var ErrUnknownToken = fmt.Errorf("unknown naming token")
+
+// namingToken matches escaped braces, or one token with an optional prefix, :format and suffix.
+// This is a simpler version of the regular expression the apps use to render file names.
+var namingToken = regexp.MustCompile(
+	`\{\{|\}\}|\{[- ._\[(]*([a-zA-Z0-9]+(?:[- ._]+[a-zA-Z0-9]+)*)(?::[^{}]*)?[- ._)\]]*\}`)
+
+// NamingToken is a token found in a naming format.
+type NamingToken struct {
+	// Text is the token as it appears in the format, including the braces.
+	Text string
+	// Name is the normalized token name: lower case, with separators replaced by a single space.
+	Name string
+}
+
+// NormalizeNamingToken returns a token name in the form used by NamingToken.Name.
+// An input of "Series.Title" returns "series title".
+func NormalizeNamingToken(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(strings.Trim(name, "{}")), func(r rune) bool {
+		return r == ' ' || r == '.' || r == '-' || r == '_'
+	}), " ")
+}
+
+// ParseNamingTokens returns every token in a naming format. Escaped braces ({{ and }}) are skipped.
+func ParseNamingTokens(format string) []*NamingToken {
+	tokens := []*NamingToken{}
+
+	for _, match := range namingToken.FindAllStringSubmatch(format, -1) {
+		if match[1] == "" {
+			continue // escaped brace.
+		}
+
+		tokens = append(tokens, &NamingToken{Text: match[0], Name: NormalizeNamingToken(match[1])})
+	}
+
+	return tokens
+}
+
+// ValidateNamingFormat returns ErrUnknownToken if format contains a token that is not in known.
+// The tokens in known may use any separator or case, like "Series Title" or "series.title".
+// The error lists every unknown token, as it appears in the format.
+func ValidateNamingFormat(format string, known []string) error {
+	valid := make(map[string]bool, len(known))
+	for _, token := range known {
+		valid[NormalizeNamingToken(token)] = true
+	}
+
+	unknown := []string{}
+
+	for _, token := range ParseNamingTokens(format) {
+		if !valid[token.Name] {
+			unknown = append(unknown, token.Text)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownToken, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// NamingQuery converts a naming config into query parameters for the naming examples endpoints.
+// Fields are named by their JSON tags, and omitted fields are left out.
+func NamingQuery(naming interface{}) (url.Values, error) {
+	data, err := json.Marshal(naming)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(naming): %w", err)
+	}
+
+	fields := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // avoid printing large numbers in scientific notation.
+
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("decoding naming: %w", err)
+	}
+
+	query := make(url.Values)
+
+	for key, val := range fields {
+		if val != nil {
+			query.Set(key, fmt.Sprint(val))
+		}
+	}
+
+	return query, nil
+}
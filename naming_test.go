@@ -0,0 +1,35 @@
+package starr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestParseNamingTokens(t *testing.T) {
+	t.Parallel()
+
+	tokens := starr.ParseNamingTokens("{{literal}} {Series.Title} - {season:00} {tmdb-{TmdbId}} {[Custom Formats]}{-Release Group}")
+	assert.Equal(t, []*starr.NamingToken{
+		{Text: "{Series.Title}", Name: "series title"},
+		{Text: "{season:00}", Name: "season"},
+		{Text: "{TmdbId}", Name: "tmdbid"},
+		{Text: "{[Custom Formats]}", Name: "custom formats"},
+		{Text: "{-Release Group}", Name: "release group"},
+	}, tokens)
+}
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	known := []string{"Series Title", "season", "Air-Date"}
+
+	require.NoError(t, starr.ValidateNamingFormat("", known))
+	require.NoError(t, starr.ValidateNamingFormat("{series_title} {Air.Date} S{season:00}", known))
+
+	err := starr.ValidateNamingFormat("{Series Titel} S{season:00} {Qualty}", known)
+	require.ErrorIs(t, err, starr.ErrUnknownToken)
+	assert.Equal(t, "unknown naming token: {Series Titel}, {Qualty}", err.Error())
+}
@@ -0,0 +1,87 @@
+package starr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+)
+
+/* This file contains the helpers used by the Patch* config methods in each app.
+ * Many config structs tag booleans with omitempty, so an Update* call cannot turn them off.
+ * Patch structs use pointers instead: nil fields are left alone, and non-nil fields are sent, even when zero.
+ */
+
+// ErrInvalidPatch is returned when a config patch or the current config is not a JSON object.
+var ErrInvalidPatch = fmt.Errorf("patch and config must be JSON objects")
+
+// PatchJSON merges the non-nil fields from patch into the current JSON object, and returns the merged object.
+// Fields in current that patch does not set, including ones this library does not know about, are kept.
+// patch should be a struct of pointers with omitempty JSON tags, like sonarr.NamingPatch. A nil patch changes nothing.
+func PatchJSON(current []byte, patch interface{}) (*bytes.Buffer, error) {
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(current, &merged); err != nil {
+		return nil, fmt.Errorf("%w: decoding current config: %w", ErrInvalidPatch, err)
+	} else if merged == nil {
+		return nil, fmt.Errorf("%w: current config is null", ErrInvalidPatch)
+	}
+
+	changes, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(patch): %w", err)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(changes, &fields); err != nil {
+		return nil, fmt.Errorf("%w: decoding patch: %w", ErrInvalidPatch, err)
+	}
+
+	for key, val := range fields {
+		merged[key] = val
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(merged); err != nil {
+		return nil, fmt.Errorf("json.Marshal(merged): %w", err)
+	}
+
+	return &body, nil
+}
+
+// PatchConfig fetches the config at uri, merges the non-nil fields from patch into it, and PUTs the result.
+// The merge happens on the raw JSON, so fields this library does not know about are sent back unchanged.
+// This is used by the Patch* config methods in each app.
+// Set withID to true for config endpoints that expect the PUT at uri/id.
+func PatchConfig(ctx context.Context, api APIer, uri string, withID bool, patch, output interface{}) error {
+	var current json.RawMessage
+
+	req := Request{URI: uri}
+	if err := api.GetInto(ctx, req, &current); err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	body, err := PatchJSON(current, patch)
+	if err != nil {
+		return fmt.Errorf("patching %s: %w", uri, err)
+	}
+
+	if withID {
+		var config struct {
+			ID int64 `json:"id"`
+		}
+
+		if err := json.Unmarshal(current, &config); err != nil {
+			return fmt.Errorf("decoding %s: %w", uri, err)
+		}
+
+		uri = path.Join(uri, fmt.Sprint(config.ID))
+	}
+
+	req = Request{URI: uri, Body: body}
+	if err := api.PutInto(ctx, req, output); err != nil {
+		return fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package starr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func TestPatchJSON(t *testing.T) {
+	t.Parallel()
+
+	patch := struct {
+		Rename  *bool   `json:"rename,omitempty"`
+		Days    *int64  `json:"days,omitempty"`
+		Format  *string `json:"format,omitempty"`
+		Skipped *bool   `json:"skipped,omitempty"`
+	}{Rename: starr.False(), Days: starr.Int64(0), Format: starr.String("")}
+
+	body, err := starr.PatchJSON([]byte(`{"id":1,"rename":true,"days":7,"format":"x","skipped":true,"unknown":[1]}`), patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"rename":false,"days":0,"format":"","skipped":true,"unknown":[1]}`, body.String())
+
+	body, err = starr.PatchJSON([]byte(`{"id":1}`), nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1}`, body.String())
+
+	_, err = starr.PatchJSON([]byte(`[1]`), patch)
+	require.ErrorIs(t, err, starr.ErrInvalidPatch)
+	_, err = starr.PatchJSON([]byte(`null`), patch)
+	require.ErrorIs(t, err, starr.ErrInvalidPatch)
+}
+
+func TestPatchConfig(t *testing.T) {
+	t.Parallel()
+
+	var put string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPut {
+			body, _ := io.ReadAll(req.Body)
+			put = req.URL.Path + " " + string(body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":4,"rename":true,"unknown":"kept"}`))
+	}))
+	defer server.Close()
+
+	var output map[string]interface{}
+
+	patch := struct {
+		Rename *bool `json:"rename,omitempty"`
+	}{Rename: starr.False()}
+
+	api := starr.New("mockAPIkey", server.URL, 0)
+	err := starr.PatchConfig(context.Background(), api, "v3/config/naming", true, patch, &output)
+	require.NoError(t, err)
+	assert.Equal(t, `/api/v3/config/naming/4 {"id":4,"rename":false,"unknown":"kept"}`+"\n", put)
+	assert.Equal(t, "kept", output["unknown"])
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -137,3 +138,96 @@ func (p *Prowlarr) DeleteDownloadClientContext(ctx context.Context, downloadclie
 
 	return nil
 }
+
+// TestDownloadClient tests a download client's settings without saving it.
+// Validation failures reported by Prowlarr are returned as a list, not as an error.
+func (p *Prowlarr) TestDownloadClient(downloadclient *DownloadClientInput) ([]*starr.ValidationFailure, error) {
+	return p.TestDownloadClientContext(context.Background(), downloadclient)
+}
+
+// TestDownloadClientContext tests a download client's settings without saving it.
+// Validation failures reported by Prowlarr are returned as a list, not as an error.
+func (p *Prowlarr) TestDownloadClientContext(ctx context.Context, downloadclient *DownloadClientInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(downloadclient); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "test"), Body: &body}
+	if err := p.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllDownloadClients tests all configured download clients and returns the result for each.
+func (p *Prowlarr) TestAllDownloadClients() ([]*starr.ProviderTestResult, error) {
+	return p.TestAllDownloadClientsContext(context.Background())
+}
+
+// TestAllDownloadClientsContext tests all configured download clients and returns the result for each.
+func (p *Prowlarr) TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "testall")}
+	if err := p.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditDownloadClients updates many download clients at once using the bulk editor.
+func (p *Prowlarr) EditDownloadClients(edit *starr.BulkEditProviders) ([]*DownloadClientOutput, error) {
+	return p.EditDownloadClientsContext(context.Background(), edit)
+}
+
+// EditDownloadClientsContext updates many download clients at once using the bulk editor.
+func (p *Prowlarr) EditDownloadClientsContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*DownloadClientOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteDownloadClients removes many download clients at once using the bulk editor.
+func (p *Prowlarr) DeleteDownloadClients(ids []int64) error {
+	return p.DeleteDownloadClientsContext(context.Background(), ids)
+}
+
+// DeleteDownloadClientsContext removes many download clients at once using the bulk editor.
+func (p *Prowlarr) DeleteDownloadClientsContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 	"time"
@@ -170,3 +171,96 @@ func (p *Prowlarr) DeleteIndexerContext(ctx context.Context, indexerID int64) er
 
 	return nil
 }
+
+// TestIndexer tests an indexer's settings without saving it.
+// Validation failures reported by Prowlarr are returned as a list, not as an error.
+func (p *Prowlarr) TestIndexer(indexer *IndexerInput) ([]*starr.ValidationFailure, error) {
+	return p.TestIndexerContext(context.Background(), indexer)
+}
+
+// TestIndexerContext tests an indexer's settings without saving it.
+// Validation failures reported by Prowlarr are returned as a list, not as an error.
+func (p *Prowlarr) TestIndexerContext(ctx context.Context, indexer *IndexerInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpIndexer, "test"), Body: &body}
+	if err := p.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllIndexers tests all configured indexers and returns the result for each.
+func (p *Prowlarr) TestAllIndexers() ([]*starr.ProviderTestResult, error) {
+	return p.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests all configured indexers and returns the result for each.
+func (p *Prowlarr) TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := p.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditIndexers updates many indexers at once using the bulk editor.
+func (p *Prowlarr) EditIndexers(edit *starr.BulkEditProviders) ([]*IndexerOutput, error) {
+	return p.EditIndexersContext(context.Background(), edit)
+}
+
+// EditIndexersContext updates many indexers at once using the bulk editor.
+func (p *Prowlarr) EditIndexersContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*IndexerOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteIndexers removes many indexers at once using the bulk editor.
+func (p *Prowlarr) DeleteIndexers(ids []int64) error {
+	return p.DeleteIndexersContext(context.Background(), ids)
+}
+
+// DeleteIndexersContext removes many indexers at once using the bulk editor.
+func (p *Prowlarr) DeleteIndexersContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
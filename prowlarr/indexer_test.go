@@ -463,3 +463,168 @@ func TestDeleteIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestTestIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			WithRequest: &prowlarr.IndexerInput{
+				Enable:       false,
+				Protocol:     "torrent",
+				AppProfileID: 2,
+				Priority:     25,
+				Name:         "Nyaa",
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "http://nyaa.si",
+					},
+					{
+						Name:  "animeStandardFormatSearch",
+						Value: false,
+					},
+				},
+				Implementation: "Rarbg",
+				ConfigContract: "RarbgSettings",
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    "{}",
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+			WithError:       nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			WithRequest: &prowlarr.IndexerInput{
+				Enable:       false,
+				Protocol:     "torrent",
+				AppProfileID: 2,
+				Priority:     25,
+				Name:         "Nyaa",
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "http://nyaa.si",
+					},
+					{
+						Name:  "animeStandardFormatSearch",
+						Value: false,
+					},
+				},
+				Implementation: "Rarbg",
+				ConfigContract: "RarbgSettings",
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody: `[{"isWarning":false,"propertyName":"BaseUrl","errorMessage":"Unable to connect to indexer",` +
+				`"severity":"error"}]`,
+			WithResponse: []*starr.ValidationFailure{
+				{
+					PropertyName: "BaseUrl",
+					ErrorMessage: "Unable to connect to indexer",
+					Severity:     "error",
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			WithRequest: &prowlarr.IndexerInput{
+				Enable:       false,
+				Protocol:     "torrent",
+				AppProfileID: 2,
+				Priority:     25,
+				Name:         "Nyaa",
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "http://nyaa.si",
+					},
+					{
+						Name:  "animeStandardFormatSearch",
+						Value: false,
+					},
+				},
+				Implementation: "Rarbg",
+				ConfigContract: "RarbgSettings",
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestIndexer(test.WithRequest.(*prowlarr.IndexerInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id":1,"isValid":true,"validationFailures":[]}]`,
+			WithResponse:   []*starr.ProviderTestResult{{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}}},
+			WithError:      nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody: `[{"id":1,"isValid":true,"validationFailures":[]},{"id":2,"isValid":false,` +
+				`"validationFailures":[{"isWarning":true,"propertyName":"","errorMessage":"No results","severity":"warning"}]}]`,
+			WithResponse: []*starr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}},
+				{ID: 2, IsValid: false, ValidationFailures: []*starr.ValidationFailure{
+					{IsWarning: true, ErrorMessage: "No results", Severity: "warning"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*starr.ProviderTestResult)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestAllIndexers()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package radarr_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const blocklistBody = `{"page":1,"pageSize":10,"sortKey":"date","sortDirection":"ascending","totalRecords":1,` +
+	`"records":[{"id":4,"movieId":10,"sourceTitle":"Some.Release.2020.1080p","languages":[{"id":1,"name":"English"}],` +
+	`"quality":{"quality":{"id":7,"name":"Bluray-1080p"}},"customFormats":[],"date":"2023-04-05T06:07:08Z",` +
+	`"protocol":"torrent","indexer":"Some Indexer","message":"Manually marked as failed"}]}`
+
+func TestGetBlocklistPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver,
+				"blocklist?page=1&pageSize=10&sortDirection=ascending&sortKey=date"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    &starr.PageReq{PageSize: 10},
+			ResponseBody:   blocklistBody,
+			WithResponse: &radarr.Blocklist{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "date",
+				SortDirection: "ascending",
+				TotalRecords:  1,
+				Records: []*radarr.BlocklistRecord{{
+					ID:            4,
+					MovieID:       10,
+					SourceTitle:   "Some.Release.2020.1080p",
+					Languages:     []*starr.Value{{ID: 1, Name: "English"}},
+					Quality:       &starr.Quality{Quality: &starr.BaseQuality{ID: 7, Name: "Bluray-1080p"}},
+					CustomFormats: []interface{}{},
+					Date:          time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC),
+					Protocol:      "torrent",
+					Indexer:       "Some Indexer",
+					Message:       "Manually marked as failed",
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver,
+				"blocklist?page=1&pageSize=10&sortDirection=ascending&sortKey=date"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    &starr.PageReq{PageSize: 10},
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   (*radarr.Blocklist)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetBlocklistPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBlocklists(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "blocklist", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ResponseStatus:  200,
+			WithRequest:     []int64{4, 5},
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "blocklist", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ResponseStatus:  404,
+			WithRequest:     []int64{4, 5},
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBlocklists(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
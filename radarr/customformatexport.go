@@ -0,0 +1,172 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golift.io/starr"
+)
+
+// CustomFormatExport is the format Radarr uses when a custom format is exported (or imported) in the web UI.
+// Specification fields are a flat name/value object instead of a list. The community, including the
+// TRaSH guides, shares custom formats as JSON in this format. Unmarshal a shared file into this struct.
+type CustomFormatExport struct {
+	TrashID               string                    `json:"trash_id,omitempty"`
+	TrashScores           map[string]int64          `json:"trash_scores,omitempty"`
+	Name                  string                    `json:"name"`
+	IncludeCFWhenRenaming bool                      `json:"includeCustomFormatWhenRenaming"`
+	Specifications        []*CustomFormatExportSpec `json:"specifications"`
+}
+
+// CustomFormatExportSpec is part of a CustomFormatExport.
+type CustomFormatExportSpec struct {
+	Name           string                 `json:"name"`
+	Implementation string                 `json:"implementation"`
+	Negate         bool                   `json:"negate"`
+	Required       bool                   `json:"required"`
+	Fields         map[string]interface{} `json:"fields"`
+}
+
+// TrashScoreDefault is the key for the default score in CustomFormatExport.TrashScores.
+const TrashScoreDefault = "default"
+
+// Input converts an exported custom format into the input for AddCustomFormat and UpdateCustomFormat.
+func (c *CustomFormatExport) Input() *CustomFormatInput {
+	input := &CustomFormatInput{
+		Name:                  c.Name,
+		IncludeCFWhenRenaming: c.IncludeCFWhenRenaming,
+		Specifications:        make([]*CustomFormatInputSpec, len(c.Specifications)),
+	}
+
+	for idx, spec := range c.Specifications {
+		input.Specifications[idx] = &CustomFormatInputSpec{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         make([]*starr.FieldInput, 0, len(spec.Fields)),
+		}
+
+		for name, value := range spec.Fields {
+			input.Specifications[idx].Fields = append(input.Specifications[idx].Fields,
+				&starr.FieldInput{Name: name, Value: value})
+		}
+
+		sort.Slice(input.Specifications[idx].Fields, func(i, j int) bool {
+			return input.Specifications[idx].Fields[i].Name < input.Specifications[idx].Fields[j].Name
+		})
+	}
+
+	return input
+}
+
+// Export converts a custom format from Radarr into the shareable export format.
+func (c *CustomFormatOutput) Export() *CustomFormatExport {
+	export := &CustomFormatExport{
+		Name:                  c.Name,
+		IncludeCFWhenRenaming: c.IncludeCFWhenRenaming,
+		Specifications:        make([]*CustomFormatExportSpec, len(c.Specifications)),
+	}
+
+	for idx, spec := range c.Specifications {
+		export.Specifications[idx] = &CustomFormatExportSpec{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         make(map[string]interface{}),
+		}
+
+		for _, field := range spec.Fields {
+			export.Specifications[idx].Fields[field.Name] = field.Value
+		}
+	}
+
+	return export
+}
+
+// SyncCustomFormats creates or updates custom formats, matched by name, and then sets their scores
+// in a quality profile. Scores are taken from the scores map (keyed by custom format name), or from
+// the format's default TRaSH score. Formats without a score keep their current score in the profile.
+// Pass a profileID of 0 to skip the quality profile. Returns the updated quality profile.
+func (r *Radarr) SyncCustomFormats(
+	profileID int64,
+	formats []*CustomFormatExport,
+	scores map[string]int64,
+) (*QualityProfile, error) {
+	return r.SyncCustomFormatsContext(context.Background(), profileID, formats, scores)
+}
+
+// SyncCustomFormatsContext creates or updates custom formats, matched by name, and then sets their scores
+// in a quality profile. Scores are taken from the scores map (keyed by custom format name), or from
+// the format's default TRaSH score. Formats without a score keep their current score in the profile.
+// Pass a profileID of 0 to skip the quality profile. Returns the updated quality profile.
+func (r *Radarr) SyncCustomFormatsContext(
+	ctx context.Context,
+	profileID int64,
+	formats []*CustomFormatExport,
+	scores map[string]int64,
+) (*QualityProfile, error) {
+	existing, err := r.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int64)
+	for _, format := range existing {
+		ids[format.Name] = format.ID
+	}
+
+	for _, format := range formats {
+		input := format.Input()
+
+		var output *CustomFormatOutput
+
+		if input.ID = ids[format.Name]; input.ID != 0 {
+			output, err = r.UpdateCustomFormatContext(ctx, input)
+		} else {
+			output, err = r.AddCustomFormatContext(ctx, input)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("syncing custom format '%s': %w", format.Name, err)
+		}
+
+		ids[format.Name] = output.ID
+	}
+
+	if profileID == 0 {
+		return nil, nil //nolint:nilnil // there is no profile to return.
+	}
+
+	profile, err := r.GetQualityProfileContext(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, format := range formats {
+		score, ok := scores[format.Name]
+		if !ok {
+			if score, ok = format.TrashScores[TrashScoreDefault]; !ok {
+				continue
+			}
+		}
+
+		profile.FormatItems = setFormatScore(profile.FormatItems, ids[format.Name], format.Name, score)
+	}
+
+	return r.UpdateQualityProfileContext(ctx, profile)
+}
+
+// setFormatScore sets the score for a custom format in a list of format items, adding it if it's missing.
+func setFormatScore(items []*starr.FormatItem, formatID int64, name string, score int64) []*starr.FormatItem {
+	for _, item := range items {
+		if item.Format == formatID {
+			item.Score = score
+			return items
+		}
+	}
+
+	return append(items, &starr.FormatItem{Format: formatID, Name: name, Score: score})
+}
@@ -0,0 +1,100 @@
+package radarr_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+)
+
+const customFormatExportBody = `{
+  "trash_id": "dc98083864ea246d05a42df0d05f81cc",
+  "trash_scores": {"default": -10000},
+  "name": "x265 (HD)",
+  "includeCustomFormatWhenRenaming": false,
+  "specifications": [{
+    "name": "x265",
+    "implementation": "ReleaseTitleSpecification",
+    "negate": false,
+    "required": true,
+    "fields": {"value": "[xh][ .]?265|\\bHEVC(\\b|\\d)"}
+  }]
+}`
+
+func TestCustomFormatExport(t *testing.T) {
+	t.Parallel()
+
+	var export radarr.CustomFormatExport
+	require.NoError(t, json.Unmarshal([]byte(customFormatExportBody), &export))
+	assert.Equal(t, int64(-10000), export.TrashScores[radarr.TrashScoreDefault])
+
+	input := export.Input()
+	assert.Equal(t, "x265 (HD)", input.Name)
+	require.Len(t, input.Specifications, 1)
+	assert.True(t, input.Specifications[0].Required)
+	assert.Equal(t, []*starr.FieldInput{{Name: "value", Value: `[xh][ .]?265|\bHEVC(\b|\d)`}},
+		input.Specifications[0].Fields)
+
+	output := &radarr.CustomFormatOutput{
+		ID:   3,
+		Name: input.Name,
+		Specifications: []*radarr.CustomFormatOutputSpec{{
+			Name:           "x265",
+			Implementation: "ReleaseTitleSpecification",
+			Required:       true,
+			Fields:         []*starr.FieldOutput{{Name: "value", Label: "Regular Expression", Value: `x265`}},
+		}},
+	}
+	exported := output.Export()
+	assert.Equal(t, "x265 (HD)", exported.Name)
+	assert.Equal(t, map[string]interface{}{"value": "x265"}, exported.Specifications[0].Fields)
+}
+
+func TestSyncCustomFormats(t *testing.T) {
+	t.Parallel()
+
+	var writes []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET /api/v3/customFormat":
+			_, _ = writer.Write([]byte(`[{"id":3,"name":"x265 (HD)"}]`))
+		case "GET /api/v3/qualityProfile/1":
+			_, _ = writer.Write([]byte(`{"id":1,"name":"HD","formatItems":[{"format":3,"name":"x265 (HD)","score":0}]}`))
+		case "POST /api/v3/customFormat":
+			writes = append(writes, req.Method+" "+req.URL.Path)
+			_, _ = writer.Write([]byte(`{"id":4,"name":"Bad Dual Groups"}`))
+		default:
+			writes = append(writes, req.Method+" "+req.URL.Path)
+			_, _ = writer.Write(body)
+		}
+	}))
+	defer server.Close()
+
+	var export radarr.CustomFormatExport
+	require.NoError(t, json.Unmarshal([]byte(customFormatExportBody), &export))
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	profile, err := client.SyncCustomFormatsContext(context.Background(), 1,
+		[]*radarr.CustomFormatExport{&export, {Name: "Bad Dual Groups"}},
+		map[string]int64{"Bad Dual Groups": -5})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"PUT /api/v3/customFormat/3",
+		"POST /api/v3/customFormat",
+		"PUT /api/v3/qualityProfile/1",
+	}, writes)
+	assert.Equal(t, []*starr.FormatItem{
+		{Format: 3, Name: "x265 (HD)", Score: -10000},
+		{Format: 4, Name: "Bad Dual Groups", Score: -5},
+	}, profile.FormatItems)
+}
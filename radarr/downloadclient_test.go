@@ -505,3 +505,105 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+func TestEditDownloadClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod: "PUT",
+			ResponseStatus: 200,
+			WithRequest: &starr.BulkEditProviders{
+				IDs:       []int64{1, 2},
+				Tags:      []int{3},
+				ApplyTags: starr.TagsAdd.Ptr(),
+				Enable:    starr.False(),
+			},
+			ExpectedRequest: `{"ids":[1,2],"tags":[3],"applyTags":"add","enable":false}` + "\n",
+			ResponseBody:    `[{"id":1,"enable":false,"tags":[3]},{"id":2,"enable":false,"tags":[3]}]`,
+			WithResponse: []*radarr.DownloadClientOutput{
+				{ID: 1, Enable: false, Tags: []int{3}},
+				{ID: 2, Enable: false, Tags: []int{3}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "200 replace with no tags",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod: "PUT",
+			ResponseStatus: 200,
+			WithRequest: &starr.BulkEditProviders{
+				IDs:       []int64{1},
+				Tags:      []int{},
+				ApplyTags: starr.TagsReplace.Ptr(),
+			},
+			ExpectedRequest: `{"ids":[1],"tags":[],"applyTags":"replace"}` + "\n",
+			ResponseBody:    `[{"id":1,"tags":[]}]`,
+			WithResponse:    []*radarr.DownloadClientOutput{{ID: 1, Tags: []int{}}},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     &starr.BulkEditProviders{IDs: []int64{1, 2}},
+			ExpectedRequest: `{"ids":[1,2],"tags":null}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    ([]*radarr.DownloadClientOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditDownloadClients(test.WithRequest.(*starr.BulkEditProviders))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteDownloadClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "DELETE",
+			WithRequest:     []int64{2, 3},
+			ExpectedRequest: `{"ids":[2,3]}` + "\n",
+			ResponseStatus:  200,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "DELETE",
+			WithRequest:     []int64{2, 3},
+			ExpectedRequest: `{"ids":[2,3]}` + "\n",
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteDownloadClients(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
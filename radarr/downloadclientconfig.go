@@ -22,6 +22,16 @@ type DownloadClientConfig struct {
 	DownloadClientWorkingFolders     string `json:"downloadClientWorkingFolders"`
 }
 
+// DownloadClientConfigPatch contains the download client config fields to change with PatchDownloadClientConfig.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type DownloadClientConfigPatch struct {
+	EnableCompletedDownloadHandling  *bool   `json:"enableCompletedDownloadHandling,omitempty"`
+	AutoRedownloadFailed             *bool   `json:"autoRedownloadFailed,omitempty"`
+	CheckForFinishedDownloadInterval *int64  `json:"checkForFinishedDownloadInterval,omitempty"`
+	DownloadClientWorkingFolders     *string `json:"downloadClientWorkingFolders,omitempty"`
+}
+
 // GetDownloadClientConfig returns the download client config.
 func (r *Radarr) GetDownloadClientConfig() (*DownloadClientConfig, error) {
 	return r.GetDownloadClientConfigContext(context.Background())
@@ -62,3 +72,21 @@ func (r *Radarr) UpdateDownloadClientConfigContext(ctx context.Context,
 
 	return &output, nil
 }
+
+// PatchDownloadClientConfig changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (r *Radarr) PatchDownloadClientConfig(patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	return r.PatchDownloadClientConfigContext(context.Background(), patch)
+}
+
+// PatchDownloadClientConfigContext changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (r *Radarr) PatchDownloadClientConfigContext(ctx context.Context, patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	var output DownloadClientConfig
+
+	if err := starr.PatchConfig(ctx, r, bpDownloadClientConfig, true, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
@@ -2,14 +2,16 @@ package radarr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
 	"golift.io/starr"
 )
 
-const bpHistory = APIver + "history"
+const bpHistory = APIver + "/history"
 
 // History is the /api/v3/history endpoint.
 type History struct {
@@ -23,6 +25,8 @@ type History struct {
 
 // HistoryRecord is part of the History data.
 // Not all items have all Data members. Check EventType for what you need.
+// The typed event accessors, like Grabbed, read data kept when the record is decoded from JSON.
+// They return empty results for a record built in Go.
 type HistoryRecord struct {
 	ID                  int64          `json:"id"`
 	MovieID             int64          `json:"movieId"`
@@ -58,6 +62,7 @@ type HistoryRecord struct {
 		TmdbID             string    `json:"tmdbId"`
 		TorrentInfoHash    string    `json:"torrentInfoHash"`
 	} `json:"data"`
+	rawData json.RawMessage
 }
 
 // GetHistory returns the Radarr History (grabs/failures/completed).
@@ -141,3 +146,118 @@ func (r *Radarr) FailContext(ctx context.Context, historyID int64) error {
 
 	return nil
 }
+
+// GetMovieHistory returns the history for a single movie.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (r *Radarr) GetMovieHistory(movieID int64, event starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetMovieHistoryContext(context.Background(), movieID, event)
+}
+
+// GetMovieHistoryContext returns the history for a single movie.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (r *Radarr) GetMovieHistoryContext(
+	ctx context.Context,
+	movieID int64,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "movie"), Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	return r.getHistoryRecords(ctx, req, event)
+}
+
+// GetHistorySince returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (r *Radarr) GetHistorySince(since time.Time, event starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetHistorySinceContext(context.Background(), since, event)
+}
+
+// GetHistorySinceContext returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterUnknown for all events.
+func (r *Radarr) GetHistorySinceContext(
+	ctx context.Context,
+	since time.Time,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Add("date", since.UTC().Format(time.RFC3339))
+
+	return r.getHistoryRecords(ctx, req, event)
+}
+
+// getHistoryRecords gets a list of history records (not a page), optionally for a single event type.
+func (r *Radarr) getHistoryRecords(
+	ctx context.Context,
+	req starr.Request,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	if event > 0 {
+		req.Query.Add("eventType", event.Param())
+	}
+
+	var output []*HistoryRecord
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UnmarshalJSON decodes a history record, and keeps a copy of the raw data for the typed event accessors.
+func (h *HistoryRecord) UnmarshalJSON(input []byte) error {
+	type record HistoryRecord // avoids recursion.
+
+	raw, err := starr.DecodeHistoryRecord(input, (*record)(h))
+	h.rawData = raw
+
+	return err //nolint:wrapcheck
+}
+
+// RawData returns every value from the record's data. The keys depend on the event type.
+func (h *HistoryRecord) RawData() (starr.HistoryData, error) {
+	return starr.ParseHistoryData(h.rawData)
+}
+
+// Event returns the record's event type and raw data.
+func (h *HistoryRecord) Event() starr.HistoryEvent {
+	return starr.HistoryEvent{Type: h.EventType, Raw: h.rawData}
+}
+
+// Grabbed returns the typed data from a grabbed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) Grabbed() (*starr.HistoryGrabbed, error) {
+	return h.Event().Grabbed() //nolint:wrapcheck
+}
+
+// DownloadFolderImported returns the typed data from a download folder imported history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadFolderImported() (*starr.HistoryImported, error) {
+	return h.Event().Imported("downloadFolderImported") //nolint:wrapcheck
+}
+
+// DownloadFailed returns the typed data from a download failed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadFailed() (*starr.HistoryDownloadFailed, error) {
+	return h.Event().DownloadFailed() //nolint:wrapcheck
+}
+
+// MovieFileDeleted returns the typed data from a movie file deleted history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) MovieFileDeleted() (*starr.HistoryFileDeleted, error) {
+	return h.Event().FileDeleted("movieFileDeleted") //nolint:wrapcheck
+}
+
+// MovieFileRenamed returns the typed data from a movie file renamed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) MovieFileRenamed() (*starr.HistoryFileRenamed, error) {
+	return h.Event().FileRenamed("movieFileRenamed") //nolint:wrapcheck
+}
+
+// DownloadIgnored returns the typed data from a download ignored history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadIgnored() (*starr.HistoryDownloadIgnored, error) {
+	return h.Event().DownloadIgnored() //nolint:wrapcheck
+}
@@ -0,0 +1,177 @@
+package radarr_test
+
+import (
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const historyRecordBody = `[{"id":9,"movieId":10,"sourceTitle":"Some.Release.2020.1080p",` +
+	`"date":"2023-04-05T06:07:08Z","eventType":"grabbed"}]`
+
+func TestGetHistoryPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver,
+				"history?page=2&pageSize=10&sortDirection=ascending&sortKey=date"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 10},
+			ResponseBody:   `{"page":2,"pageSize":10,"totalRecords":11,"records":[{"id":9,"movieId":10}]}`,
+			WithResponse: &radarr.History{
+				Page:         2,
+				PageSize:     10,
+				TotalRecords: 11,
+				Records:      []*radarr.HistoryRecord{{ID: 9, MovieID: 10}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver,
+				"history?page=2&pageSize=10&sortDirection=ascending&sortKey=date"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 10},
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   (*radarr.History)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistoryPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetMovieHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history", "movie?eventType=1&movieId=10"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(10),
+			ResponseBody:   historyRecordBody,
+			WithResponse: []*radarr.HistoryRecord{{
+				ID:          9,
+				MovieID:     10,
+				SourceTitle: "Some.Release.2020.1080p",
+				Date:        time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC),
+				EventType:   "grabbed",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history", "movie?eventType=1&movieId=10"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(10),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*radarr.HistoryRecord(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMovieHistory(test.WithRequest.(int64), radarr.FilterGrabbed)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetHistorySince(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC)
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history", "since?date=2023-04-05T00%3A00%3A00Z"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    since,
+			ResponseBody:   historyRecordBody,
+			WithResponse: []*radarr.HistoryRecord{{
+				ID:          9,
+				MovieID:     10,
+				SourceTitle: "Some.Release.2020.1080p",
+				Date:        time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC),
+				EventType:   "grabbed",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history", "since?date=2023-04-05T00%3A00%3A00Z"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    since,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*radarr.HistoryRecord(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistorySince(test.WithRequest.(time.Time), radarr.FilterUnknown)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestHistoryRecordGrabbed(t *testing.T) {
+	t.Parallel()
+
+	var record radarr.HistoryRecord
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":9,"eventType":"grabbed","data":{"indexer":"Some Indexer",`+
+		`"size":"8589934592","ageHours":"1.5","publishedDate":"2023-04-05T06:07:08Z","downloadForced":"True",`+
+		`"tmdbId":"603"}}`), &record))
+	assert.Equal(t, "Some Indexer", record.Data.Indexer, "the untyped data must still be decoded")
+
+	grabbed, err := record.Grabbed()
+	require.NoError(t, err)
+	assert.Equal(t, "Some Indexer", grabbed.Indexer)
+	assert.Equal(t, int64(8589934592), grabbed.Size)
+	assert.InDelta(t, 1.5, grabbed.AgeHours, 0)
+	assert.Equal(t, time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC), grabbed.PublishedDate)
+	assert.True(t, grabbed.DownloadForced)
+	assert.Equal(t, int64(603), grabbed.Data.Int64("tmdbId"))
+
+	_, err = record.MovieFileDeleted()
+	assert.ErrorIs(t, err, starr.ErrHistoryEvent)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -138,3 +139,96 @@ func (r *Radarr) UpdateImportListContext(ctx context.Context, list *ImportList)
 
 	return &output, nil
 }
+
+// TestImportList tests an import list's settings without saving it.
+// Validation failures reported by Radarr are returned as a list, not as an error.
+func (r *Radarr) TestImportList(list *ImportList) ([]*starr.ValidationFailure, error) {
+	return r.TestImportListContext(context.Background(), list)
+}
+
+// TestImportListContext tests an import list's settings without saving it.
+// Validation failures reported by Radarr are returned as a list, not as an error.
+func (r *Radarr) TestImportListContext(ctx context.Context, list *ImportList) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "test"), Body: &body}
+	if err := r.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllImportLists tests all configured import lists and returns the result for each.
+func (r *Radarr) TestAllImportLists() ([]*starr.ProviderTestResult, error) {
+	return r.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests all configured import lists and returns the result for each.
+func (r *Radarr) TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := r.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditImportLists updates many import lists at once using the bulk editor.
+func (r *Radarr) EditImportLists(edit *starr.BulkEditProviders) ([]*ImportList, error) {
+	return r.EditImportListsContext(context.Background(), edit)
+}
+
+// EditImportListsContext updates many import lists at once using the bulk editor.
+func (r *Radarr) EditImportListsContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*ImportList, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output []*ImportList
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteImportLists removes many import lists at once using the bulk editor.
+func (r *Radarr) DeleteImportLists(ids []int64) error {
+	return r.DeleteImportListsContext(context.Background(), ids)
+}
+
+// DeleteImportListsContext removes many import lists at once using the bulk editor.
+func (r *Radarr) DeleteImportListsContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
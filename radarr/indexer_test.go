@@ -476,3 +476,177 @@ func TestDeleteIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestTestIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			WithRequest: &radarr.IndexerInput{
+				EnableAutomaticSearch:   true,
+				EnableInteractiveSearch: true,
+				EnableRss:               true,
+				DownloadClientID:        0,
+				Priority:                25,
+				ConfigContract:          "NewznabSettings",
+				Implementation:          "Newznab",
+				Name:                    "NZBgeek",
+				Protocol:                "usenet",
+				Tags:                    []int{},
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "https://api.nzbgeek.info",
+					},
+					{
+						Name:  "apiPath",
+						Value: "/api",
+					},
+				},
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    "{}",
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+			WithError:       nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			WithRequest: &radarr.IndexerInput{
+				EnableAutomaticSearch:   true,
+				EnableInteractiveSearch: true,
+				EnableRss:               true,
+				DownloadClientID:        0,
+				Priority:                25,
+				ConfigContract:          "NewznabSettings",
+				Implementation:          "Newznab",
+				Name:                    "NZBgeek",
+				Protocol:                "usenet",
+				Tags:                    []int{},
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "https://api.nzbgeek.info",
+					},
+					{
+						Name:  "apiPath",
+						Value: "/api",
+					},
+				},
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody: `[{"isWarning":false,"propertyName":"BaseUrl","errorMessage":"Unable to connect to indexer",` +
+				`"severity":"error"}]`,
+			WithResponse: []*starr.ValidationFailure{
+				{
+					PropertyName: "BaseUrl",
+					ErrorMessage: "Unable to connect to indexer",
+					Severity:     "error",
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "test"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			WithRequest: &radarr.IndexerInput{
+				EnableAutomaticSearch:   true,
+				EnableInteractiveSearch: true,
+				EnableRss:               true,
+				DownloadClientID:        0,
+				Priority:                25,
+				ConfigContract:          "NewznabSettings",
+				Implementation:          "Newznab",
+				Name:                    "NZBgeek",
+				Protocol:                "usenet",
+				Tags:                    []int{},
+				Fields: []*starr.FieldInput{
+					{
+						Name:  "baseUrl",
+						Value: "https://api.nzbgeek.info",
+					},
+					{
+						Name:  "apiPath",
+						Value: "/api",
+					},
+				},
+			},
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestIndexer(test.WithRequest.(*radarr.IndexerInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id":1,"isValid":true,"validationFailures":[]}]`,
+			WithResponse:   []*starr.ProviderTestResult{{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}}},
+			WithError:      nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody: `[{"id":1,"isValid":true,"validationFailures":[]},{"id":2,"isValid":false,` +
+				`"validationFailures":[{"isWarning":true,"propertyName":"","errorMessage":"No results","severity":"warning"}]}]`,
+			WithResponse: []*starr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}},
+				{ID: 2, IsValid: false, ValidationFailures: []*starr.ValidationFailure{
+					{IsWarning: true, ErrorMessage: "No results", Severity: "warning"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*starr.ProviderTestResult)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestAllIndexers()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
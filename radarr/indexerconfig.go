@@ -25,6 +25,20 @@ type IndexerConfig struct {
 	AllowHardcodedSubs       bool   `json:"allowHardcodedSubs"`
 }
 
+// IndexerConfigPatch contains the indexer config fields to change with PatchIndexerConfig.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type IndexerConfigPatch struct {
+	WhitelistedHardcodedSubs *string `json:"whitelistedHardcodedSubs,omitempty"`
+	MaximumSize              *int64  `json:"maximumSize,omitempty"`
+	MinimumAge               *int64  `json:"minimumAge,omitempty"`
+	Retention                *int64  `json:"retention,omitempty"`
+	RssSyncInterval          *int64  `json:"rssSyncInterval,omitempty"`
+	AvailabilityDelay        *int64  `json:"availabilityDelay,omitempty"`
+	PreferIndexerFlags       *bool   `json:"preferIndexerFlags,omitempty"`
+	AllowHardcodedSubs       *bool   `json:"allowHardcodedSubs,omitempty"`
+}
+
 // GetIndexerConfig returns an Indexer Config.
 func (r *Radarr) GetIndexerConfig() (*IndexerConfig, error) {
 	return r.GetIndexerConfigContext(context.Background())
@@ -63,3 +77,21 @@ func (r *Radarr) UpdateIndexerConfigContext(ctx context.Context, indexerConfig *
 
 	return &output, nil
 }
+
+// PatchIndexerConfig changes only the non-nil fields in patch, and leaves the rest of the indexer config alone.
+// This fetches the current indexer config, merges the patch into it, and updates it.
+func (r *Radarr) PatchIndexerConfig(patch *IndexerConfigPatch) (*IndexerConfig, error) {
+	return r.PatchIndexerConfigContext(context.Background(), patch)
+}
+
+// PatchIndexerConfigContext changes only the non-nil fields in patch, and leaves the rest of the indexer config alone.
+// This fetches the current indexer config, merges the patch into it, and updates it.
+func (r *Radarr) PatchIndexerConfigContext(ctx context.Context, patch *IndexerConfigPatch) (*IndexerConfig, error) {
+	var output IndexerConfig
+
+	if err := starr.PatchConfig(ctx, r, bpIndexerConfig, true, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
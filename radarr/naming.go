@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
@@ -26,6 +27,21 @@ type Naming struct {
 	NumberStyle              string `json:"numberStylet,omitempty"`
 }
 
+// NamingPatch contains the naming config fields to change with PatchNaming.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type NamingPatch struct {
+	RenameMovies             *bool   `json:"renameMovies,omitempty"`
+	ReplaceIllegalCharacters *bool   `json:"replaceIllegalCharacters,omitempty"`
+	IncludeQuality           *bool   `json:"includeQuality,omitempty"`
+	ReplaceSpaces            *bool   `json:"replaceSpaces,omitempty"`
+	ColonReplacementFormat   *string `json:"colonReplacementFormat,omitempty"`
+	StandardMovieFormat      *string `json:"standardMovieFormat,omitempty"`
+	MovieFolderFormat        *string `json:"movieFolderFormat,omitempty"`
+	Separator                *string `json:"separator,omitempty"`
+	NumberStyle              *string `json:"numberStyle,omitempty"`
+}
+
 // GetNaming returns the file naming rules.
 func (r *Radarr) GetNaming() (*Naming, error) {
 	return r.GetNamingContext(context.Background())
@@ -64,3 +80,119 @@ func (r *Radarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nami
 
 	return &output, nil
 }
+
+// PatchNaming changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (r *Radarr) PatchNaming(patch *NamingPatch) (*Naming, error) {
+	return r.PatchNamingContext(context.Background(), patch)
+}
+
+// PatchNamingContext changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (r *Radarr) PatchNamingContext(ctx context.Context, patch *NamingPatch) (*Naming, error) {
+	var output Naming
+
+	if err := starr.PatchConfig(ctx, r, bpNaming, false, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
+
+// NamingExamples contains the sample file and folder names rendered from a naming config.
+type NamingExamples struct {
+	MovieExample       string `json:"movieExample"`
+	MovieFolderExample string `json:"movieFolderExample"`
+}
+
+// namingTokens are the tokens Radarr accepts in naming formats. Some take a :format, like {Release Year:0000}.
+//
+//nolint:gochecknoglobals
+var namingTokens = []string{
+	"Movie Title",
+	"Movie TitleYear",
+	"Movie CleanTitle",
+	"Movie CleanTitleYear",
+	"Movie TitleThe",
+	"Movie CleanTitleThe",
+	"Movie TitleFirstCharacter",
+	"Movie OriginalTitle",
+	"Movie CleanOriginalTitle",
+	"Movie Collection",
+	"Movie Certification",
+	"Release Year",
+	"Edition Tags",
+	"ImdbId",
+	"TmdbId",
+	"Quality Proper",
+	"Quality Real",
+	"MediaInfo Simple",
+	"MediaInfo Full",
+	"MediaInfo Video",
+	"MediaInfo VideoCodec",
+	"MediaInfo VideoBitDepth",
+	"MediaInfo VideoDynamicRange",
+	"MediaInfo VideoDynamicRangeType",
+	"MediaInfo Audio",
+	"MediaInfo AudioCodec",
+	"MediaInfo AudioChannels",
+	"MediaInfo AudioLanguages",
+	"MediaInfo AudioLanguagesAll",
+	"MediaInfo SubtitleLanguages",
+	"MediaInfo SubtitleLanguagesAll",
+	"MediaInfo 3D",
+	"Release Hash",
+	"Quality Full",
+	"Quality Title",
+	"Release Group",
+	"Custom Formats",
+	"Custom Format",
+	"Custom Format Score",
+	"Original Title",
+	"Original Filename",
+}
+
+// GetNamingExamples renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (r *Radarr) GetNamingExamples(naming *Naming) (*NamingExamples, error) {
+	return r.GetNamingExamplesContext(context.Background(), naming)
+}
+
+// GetNamingExamplesContext renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (r *Radarr) GetNamingExamplesContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	query, err := starr.NamingQuery(naming)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped.
+	}
+
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: query}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// ValidateNamingFormat returns starr.ErrUnknownToken if a naming format contains a token Radarr does not support.
+// This check is local; it does not contact Radarr. An input like "{Movie CleanTitle} {(Release Year)}" is valid.
+func ValidateNamingFormat(format string) error {
+	return starr.ValidateNamingFormat(format, namingTokens) //nolint:wrapcheck // the error is already wrapped.
+}
+
+// Validate checks every format in the naming config for unknown tokens. Empty formats are skipped.
+// Returns an error wrapping starr.ErrUnknownToken that names the first invalid format.
+func (n *Naming) Validate() error {
+	for _, format := range []struct{ name, format string }{
+		{"StandardMovieFormat", n.StandardMovieFormat},
+		{"MovieFolderFormat", n.MovieFolderFormat},
+	} {
+		if err := ValidateNamingFormat(format.format); err != nil {
+			return fmt.Errorf("%s: %w", format.name, err)
+		}
+	}
+
+	return nil
+}
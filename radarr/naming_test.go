@@ -124,3 +124,23 @@ func TestUpdateNaming(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{
+		// The Radarr defaults.
+		"{Movie Title} ({Release Year}) {Quality Full}",
+		"{Movie Title} ({Release Year})",
+		// A few other real formats.
+		`{Movie CleanTitle} {(Release Year)} {imdb-{ImdbId}} {edition-{Edition Tags}` +
+			`} {[Custom Formats]}{[Quality Full]}{[MediaInfo 3D]}{[MediaInfo VideoDynamicRangeType]}` +
+			`{[Mediainfo AudioCodec}{ Mediainfo AudioChannels]}{MediaInfo AudioLanguages}` +
+			`{[Mediainfo VideoCodec]}{-Release Group}`,
+		"{Movie Collection}/{Movie TitleThe} ({Release Year}) {tmdb-{TmdbId}}",
+	} {
+		assert.NoError(t, radarr.ValidateNamingFormat(format), format)
+	}
+
+	assert.ErrorIs(t, radarr.ValidateNamingFormat("{Release Year} {Release Yaer}"), starr.ErrUnknownToken)
+}
@@ -0,0 +1,60 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is a file that does not match the naming config, from the /api/v3/rename endpoint.
+type Rename struct {
+	MovieID      int64  `json:"movieId"`
+	MovieFileID  int64  `json:"movieFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// GetRename returns the existing and new path for each movie file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (r *Radarr) GetRename(movieID int64) ([]*Rename, error) {
+	return r.GetRenameContext(context.Background(), movieID)
+}
+
+// GetRenameContext returns the existing and new path for each movie file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (r *Radarr) GetRenameContext(ctx context.Context, movieID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RenameFiles renames files that belong to a movie, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (r *Radarr) RenameFiles(movieID int64, fileIDs []int64) (*CommandResponse, error) {
+	return r.RenameFilesContext(context.Background(), movieID, fileIDs)
+}
+
+// RenameFilesContext renames files that belong to a movie, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+// Cancel the context to stop waiting; the command keeps running in Radarr.
+func (r *Radarr) RenameFilesContext(ctx context.Context, movieID int64, fileIDs []int64) (*CommandResponse, error) {
+	cmd, err := r.SendCommandContext(ctx, &CommandRequest{Name: "RenameFiles", MovieID: movieID, Files: fileIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.WaitForCommandContext(ctx, cmd, starr.DefaultCommandInterval)
+}
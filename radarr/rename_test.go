@@ -0,0 +1,97 @@
+package radarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetRename(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "rename") + "?movieId=7",
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(7),
+			ResponseBody: `[{"movieId":7,"movieFileId":12,"existingPath":"Movie (2020)/old.mkv",` +
+				`"newPath":"Movie (2020)/Movie (2020) Bluray-1080p.mkv"}]`,
+			WithResponse: []*radarr.Rename{{
+				MovieID:      7,
+				MovieFileID:  12,
+				ExistingPath: "Movie (2020)/old.mkv",
+				NewPath:      "Movie (2020)/Movie (2020) Bluray-1080p.mkv",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "rename") + "?movieId=7",
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(7),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*radarr.Rename(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRename(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestRenameFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "command"),
+			ExpectedMethod:  "POST",
+			ExpectedRequest: `{"name":"RenameFiles","files":[12,13],"movieId":7}` + "\n",
+			ResponseStatus:  201,
+			ResponseBody:    `{"id":99,"name":"RenameFiles","status":"completed"}`,
+			WithResponse:    &radarr.CommandResponse{ID: 99, Name: "RenameFiles", Status: starr.CommandCompleted},
+			WithError:       nil,
+		},
+		{
+			Name:            "failed",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "command"),
+			ExpectedMethod:  "POST",
+			ExpectedRequest: `{"name":"RenameFiles","files":[12,13],"movieId":7}` + "\n",
+			ResponseStatus:  201,
+			ResponseBody:    `{"id":99,"name":"RenameFiles","status":"failed","message":"access denied"}`,
+			WithResponse: &radarr.CommandResponse{
+				ID: 99, Name: "RenameFiles", Status: starr.CommandFailed, Message: "access denied",
+			},
+			WithError: starr.ErrCommandFailed,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.RenameFiles(7, []int64{12, 13})
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -78,6 +78,36 @@ type Statistics struct {
 	AvailableBookCount int     `json:"availableBookCount"`
 }
 
+// AddAuthorInput is the input to add an author.
+type AddAuthorInput struct {
+	AuthorName        string            `json:"authorName,omitempty"`
+	ForeignAuthorID   string            `json:"foreignAuthorId"`   // required
+	QualityProfileID  int64             `json:"qualityProfileId"`  // required
+	MetadataProfileID int64             `json:"metadataProfileId"` // required
+	RootFolderPath    string            `json:"rootFolderPath"`    // required
+	MonitorNewItems   string            `json:"monitorNewItems,omitempty"`
+	Monitored         bool              `json:"monitored"`
+	Tags              []int             `json:"tags"`
+	AddOptions        *AddAuthorOptions `json:"addOptions,omitempty"`
+}
+
+// GetAuthors returns all authors.
+func (r *Readarr) GetAuthors() ([]*Author, error) {
+	return r.GetAuthorsContext(context.Background())
+}
+
+// GetAuthorsContext returns all authors.
+func (r *Readarr) GetAuthorsContext(ctx context.Context) ([]*Author, error) {
+	var output []*Author
+
+	req := starr.Request{URI: bpAuthor}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
 // GetAuthorByID returns an author.
 func (r *Readarr) GetAuthorByID(authorID int64) (*Author, error) {
 	return r.GetAuthorByIDContext(context.Background(), authorID)
@@ -122,3 +152,74 @@ func (r *Readarr) UpdateAuthorContext(ctx context.Context, authorID int64, autho
 
 	return nil
 }
+
+// AddAuthor adds a new author to the library.
+func (r *Readarr) AddAuthor(author *AddAuthorInput) (*Author, error) {
+	return r.AddAuthorContext(context.Background(), author)
+}
+
+// AddAuthorContext adds a new author to the library.
+func (r *Readarr) AddAuthorContext(ctx context.Context, author *AddAuthorInput) (*Author, error) {
+	if author.Tags == nil {
+		author.Tags = make([]int, 0)
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(author); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpAuthor, err)
+	}
+
+	var output Author
+
+	req := starr.Request{URI: bpAuthor, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// LookupAuthor will search for authors matching the specified search term.
+func (r *Readarr) LookupAuthor(term string) ([]*Author, error) {
+	return r.LookupAuthorContext(context.Background(), term)
+}
+
+// LookupAuthorContext will search for authors matching the specified search term.
+func (r *Readarr) LookupAuthorContext(ctx context.Context, term string) ([]*Author, error) {
+	var output []*Author
+
+	if term == "" {
+		return output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpAuthor, "lookup"), Query: make(url.Values)}
+	req.Query.Set("term", term)
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteAuthor removes an author.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (r *Readarr) DeleteAuthor(authorID int64, deleteFiles, importExclude bool) error {
+	return r.DeleteAuthorContext(context.Background(), authorID, deleteFiles, importExclude)
+}
+
+// DeleteAuthorContext removes an author.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (r *Readarr) DeleteAuthorContext(ctx context.Context, authorID int64, deleteFiles, importExclude bool) error {
+	req := starr.Request{URI: path.Join(bpAuthor, fmt.Sprint(authorID)), Query: make(url.Values)}
+	req.Query.Add("deleteFiles", fmt.Sprint(deleteFiles))
+	req.Query.Add("addImportListExclusion", fmt.Sprint(importExclude))
+
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golift.io/starr"
+)
+
+const bpAuthorEditor = bpAuthor + "/editor"
+
+// BulkEditAuthors is the input for the bulk author editor endpoint.
+// You may use starr.True(), starr.False(), starr.Int64(), and starr.String() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for apply tags. Tags are always sent; with ApplyTags set to TagsReplace,
+// an empty (not nil) list removes every tag.
+type BulkEditAuthors struct {
+	AuthorIDs         []int64          `json:"authorIds"`
+	Monitored         *bool            `json:"monitored,omitempty"`
+	MonitorNewItems   *string          `json:"monitorNewItems,omitempty"` // all, none, new
+	QualityProfileID  *int64           `json:"qualityProfileId,omitempty"`
+	MetadataProfileID *int64           `json:"metadataProfileId,omitempty"`
+	RootFolderPath    *string          `json:"rootFolderPath,omitempty"`
+	Tags              []int            `json:"tags"`
+	ApplyTags         *starr.ApplyTags `json:"applyTags,omitempty"`
+	MoveFiles         *bool            `json:"moveFiles,omitempty"`
+	DeleteFiles       *bool            `json:"deleteFiles,omitempty"` // delete only
+}
+
+// EditAuthors allows bulk editing many authors at once.
+func (r *Readarr) EditAuthors(editAuthors *BulkEditAuthors) ([]*Author, error) {
+	return r.EditAuthorsContext(context.Background(), editAuthors)
+}
+
+// EditAuthorsContext allows bulk editing many authors at once.
+func (r *Readarr) EditAuthorsContext(ctx context.Context, editAuthors *BulkEditAuthors) ([]*Author, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editAuthors); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpAuthorEditor, err)
+	}
+
+	var output []*Author
+
+	req := starr.Request{URI: bpAuthorEditor, Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteAuthors bulk deletes authors. Can also delete their files.
+func (r *Readarr) DeleteAuthors(deleteAuthors *BulkEditAuthors) error {
+	return r.DeleteAuthorsContext(context.Background(), deleteAuthors)
+}
+
+// DeleteAuthorsContext bulk deletes authors. Can also delete their files.
+func (r *Readarr) DeleteAuthorsContext(ctx context.Context, deleteAuthors *BulkEditAuthors) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(deleteAuthors); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpAuthorEditor, err)
+	}
+
+	req := starr.Request{URI: bpAuthorEditor, Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
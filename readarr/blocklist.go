@@ -0,0 +1,134 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpBlocklist = APIver + "/blocklist"
+
+// Blocklist is the /api/v1/blocklist endpoint.
+type Blocklist struct {
+	Page          int                `json:"page"`
+	PageSize      int                `json:"pageSize"`
+	SortKey       string             `json:"sortKey"`
+	SortDirection string             `json:"sortDirection"`
+	TotalRecords  int                `json:"totalRecords"`
+	Records       []*BlocklistRecord `json:"records"`
+}
+
+// BlocklistRecord is a blocked release, and part of the Blocklist data.
+type BlocklistRecord struct {
+	ID          int64          `json:"id"`
+	AuthorID    int64          `json:"authorId"`
+	BookIDs     []int64        `json:"bookIds"`
+	SourceTitle string         `json:"sourceTitle"`
+	Quality     *starr.Quality `json:"quality"`
+	Date        time.Time      `json:"date"`
+	Protocol    string         `json:"protocol"`
+	Indexer     string         `json:"indexer"`
+	Message     string         `json:"message"`
+	Author      *Author        `json:"author,omitempty"`
+}
+
+// GetBlocklist returns the Readarr blocklist.
+// This function simply returns the number of blocklist records desired,
+// up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates
+// them into one list. Passing zero for records will return all of them.
+func (r *Readarr) GetBlocklist(records, perPage int) (*Blocklist, error) {
+	return r.GetBlocklistContext(context.Background(), records, perPage)
+}
+
+// GetBlocklistContext returns the Readarr blocklist.
+func (r *Readarr) GetBlocklistContext(ctx context.Context, records, perPage int) (*Blocklist, error) {
+	blocklist := &Blocklist{Records: []*BlocklistRecord{}}
+	perPage = starr.SetPerPage(records, perPage)
+
+	for page := 1; ; page++ {
+		curr, err := r.GetBlocklistPageContext(ctx, &starr.PageReq{PageSize: perPage, Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		blocklist.Records = append(blocklist.Records, curr.Records...)
+		if len(blocklist.Records) >= curr.TotalRecords ||
+			(len(blocklist.Records) >= records && records != 0) ||
+			len(curr.Records) == 0 {
+			blocklist.PageSize = curr.TotalRecords
+			blocklist.TotalRecords = curr.TotalRecords
+			blocklist.SortDirection = curr.SortDirection
+			blocklist.SortKey = curr.SortKey
+
+			break
+		}
+
+		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(blocklist.Records), perPage)
+	}
+
+	return blocklist, nil
+}
+
+// GetBlocklistPage returns a single page from the Readarr blocklist.
+// The page size and number is configurable with the input request parameters.
+func (r *Readarr) GetBlocklistPage(params *starr.PageReq) (*Blocklist, error) {
+	return r.GetBlocklistPageContext(context.Background(), params)
+}
+
+// GetBlocklistPageContext returns a single page from the Readarr blocklist.
+// The page size and number is configurable with the input request parameters.
+func (r *Readarr) GetBlocklistPageContext(ctx context.Context, params *starr.PageReq) (*Blocklist, error) {
+	var output Blocklist
+
+	req := starr.Request{URI: bpBlocklist, Query: params.Params()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteBlocklist removes a single blocklist item.
+func (r *Readarr) DeleteBlocklist(listID int64) error {
+	return r.DeleteBlocklistContext(context.Background(), listID)
+}
+
+// DeleteBlocklistContext removes a single blocklist item.
+func (r *Readarr) DeleteBlocklistContext(ctx context.Context, listID int64) error {
+	req := starr.Request{URI: path.Join(bpBlocklist, fmt.Sprint(listID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteBlocklists removes many blocklist items at once.
+func (r *Readarr) DeleteBlocklists(ids []int64) error {
+	return r.DeleteBlocklistsContext(context.Background(), ids)
+}
+
+// DeleteBlocklistsContext removes many blocklist items at once.
+func (r *Readarr) DeleteBlocklistsContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBlocklist, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpBlocklist, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -222,3 +222,25 @@ func (r *Readarr) LookupContext(ctx context.Context, term string) ([]*Book, erro
 
 	return output, nil
 }
+
+// DeleteBook removes a book.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (r *Readarr) DeleteBook(bookID int64, deleteFiles, importExclude bool) error {
+	return r.DeleteBookContext(context.Background(), bookID, deleteFiles, importExclude)
+}
+
+// DeleteBookContext removes a book.
+// deleteFiles flag defines the deleteFiles query parameter.
+// importExclude defines the addImportListExclusion query parameter.
+func (r *Readarr) DeleteBookContext(ctx context.Context, bookID int64, deleteFiles, importExclude bool) error {
+	req := starr.Request{URI: path.Join(bpBook, fmt.Sprint(bookID)), Query: make(url.Values)}
+	req.Query.Add("deleteFiles", fmt.Sprint(deleteFiles))
+	req.Query.Add("addImportListExclusion", fmt.Sprint(importExclude))
+
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
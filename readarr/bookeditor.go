@@ -0,0 +1,91 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpBookEditor = bpBook + "/editor"
+
+// BulkEditBooks is the input for the bulk book editor endpoint.
+// You may use starr.True() and starr.False() to add data to the struct members.
+type BulkEditBooks struct {
+	BookIDs            []int64 `json:"bookIds"`
+	Monitored          *bool   `json:"monitored,omitempty"`
+	DeleteFiles        *bool   `json:"deleteFiles,omitempty"`            // delete only
+	AddImportExclusion *bool   `json:"addImportListExclusion,omitempty"` // delete only
+}
+
+// EditBooks allows bulk editing many books at once.
+func (r *Readarr) EditBooks(editBooks *BulkEditBooks) ([]*Book, error) {
+	return r.EditBooksContext(context.Background(), editBooks)
+}
+
+// EditBooksContext allows bulk editing many books at once.
+func (r *Readarr) EditBooksContext(ctx context.Context, editBooks *BulkEditBooks) ([]*Book, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editBooks); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpBookEditor, err)
+	}
+
+	var output []*Book
+
+	req := starr.Request{URI: bpBookEditor, Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBooks bulk deletes books. Can also mark them as excluded, and delete their files.
+func (r *Readarr) DeleteBooks(deleteBooks *BulkEditBooks) error {
+	return r.DeleteBooksContext(context.Background(), deleteBooks)
+}
+
+// DeleteBooksContext bulk deletes books. Can also mark them as excluded, and delete their files.
+func (r *Readarr) DeleteBooksContext(ctx context.Context, deleteBooks *BulkEditBooks) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(deleteBooks); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBookEditor, err)
+	}
+
+	req := starr.Request{URI: bpBookEditor, Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// MonitorBooks sets the monitored flag on many books at once.
+func (r *Readarr) MonitorBooks(bookIDs []int64, monitored bool) error {
+	return r.MonitorBooksContext(context.Background(), bookIDs, monitored)
+}
+
+// MonitorBooksContext sets the monitored flag on many books at once.
+func (r *Readarr) MonitorBooksContext(ctx context.Context, bookIDs []int64, monitored bool) error {
+	postData := struct {
+		B []int64 `json:"bookIds"`
+		M bool    `json:"monitored"`
+	}{bookIDs, monitored}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBook, err)
+	}
+
+	var output interface{}
+
+	req := starr.Request{URI: path.Join(bpBook, "monitor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return nil
+}
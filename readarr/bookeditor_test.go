@@ -0,0 +1,103 @@
+package readarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestMonitorBooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "book", "monitor"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  202,
+			WithRequest:     []int64{1, 2},
+			ExpectedRequest: `{"bookIds":[1,2],"monitored":true}` + "\n",
+			ResponseBody:    `{"bookIds":[1,2],"monitored":true}`,
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "book", "monitor"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     []int64{1, 2},
+			ExpectedRequest: `{"bookIds":[1,2],"monitored":true}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.MonitorBooks(test.WithRequest.([]int64), true)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestEditAuthors(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     &readarr.BulkEditAuthors{AuthorIDs: []int64{3}, Monitored: starr.False()},
+			ExpectedRequest: `{"authorIds":[3],"monitored":false,"tags":null}` + "\n",
+			ResponseBody:    `[{"id":3,"authorName":"Someone","monitored":false}]`,
+			WithResponse:    []*readarr.Author{{ID: 3, AuthorName: "Someone"}},
+			WithError:       nil,
+		},
+		{
+			Name:           "200 replace with no tags",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod: "PUT",
+			ResponseStatus: 200,
+			WithRequest: &readarr.BulkEditAuthors{
+				AuthorIDs: []int64{3}, Tags: []int{}, ApplyTags: starr.TagsReplace.Ptr(),
+			},
+			ExpectedRequest: `{"authorIds":[3],"tags":[],"applyTags":"replace"}` + "\n",
+			ResponseBody:    `[{"id":3,"authorName":"Someone","monitored":false}]`,
+			WithResponse:    []*readarr.Author{{ID: 3, AuthorName: "Someone"}},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     &readarr.BulkEditAuthors{AuthorIDs: []int64{3}, Monitored: starr.False()},
+			ExpectedRequest: `{"authorIds":[3],"monitored":false,"tags":null}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithResponse:    []*readarr.Author(nil),
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditAuthors(test.WithRequest.(*readarr.BulkEditAuthors))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
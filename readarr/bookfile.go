@@ -0,0 +1,211 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpBookFile = APIver + "/bookfile"
+
+// BookFile represents the data sent to and returned from the bookfile endpoint.
+type BookFile struct {
+	ID            int64          `json:"id"`
+	AuthorID      int64          `json:"authorId"`
+	BookID        int64          `json:"bookId"`
+	Path          string         `json:"path"`
+	Size          int64          `json:"size"`
+	DateAdded     time.Time      `json:"dateAdded"`
+	Quality       *starr.Quality `json:"quality"`
+	QualityWeight int            `json:"qualityWeight"`
+	MediaInfo     *MediaInfo     `json:"mediaInfo,omitempty"`
+	CutoffNotMet  bool           `json:"qualityCutoffNotMet"`
+}
+
+// MediaInfo is part of a BookFile. Only audio books have media info.
+type MediaInfo struct {
+	ID              int64   `json:"id"`
+	AudioChannels   float64 `json:"audioChannels"`
+	AudioBitRate    string  `json:"audioBitRate"`
+	AudioCodec      string  `json:"audioCodec"`
+	AudioBits       string  `json:"audioBits"`
+	AudioSampleRate string  `json:"audioSampleRate"`
+}
+
+// GetBookFilesForAuthor returns the book files for an author.
+func (r *Readarr) GetBookFilesForAuthor(authorID int64) ([]*BookFile, error) {
+	return r.GetBookFilesForAuthorContext(context.Background(), authorID)
+}
+
+// GetBookFilesForAuthorContext returns the book files for an author.
+func (r *Readarr) GetBookFilesForAuthorContext(ctx context.Context, authorID int64) ([]*BookFile, error) {
+	var output []*BookFile
+
+	req := starr.Request{URI: bpBookFile, Query: make(url.Values)}
+	req.Query.Add("authorId", fmt.Sprint(authorID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetBookFilesForBook returns the book files for a book.
+func (r *Readarr) GetBookFilesForBook(bookID int64) ([]*BookFile, error) {
+	return r.GetBookFilesForBookContext(context.Background(), bookID)
+}
+
+// GetBookFilesForBookContext returns the book files for a book.
+func (r *Readarr) GetBookFilesForBookContext(ctx context.Context, bookID int64) ([]*BookFile, error) {
+	var output []*BookFile
+
+	req := starr.Request{URI: bpBookFile, Query: make(url.Values)}
+	req.Query.Add("bookId", fmt.Sprint(bookID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetBookFiles returns the requested book files by ID.
+func (r *Readarr) GetBookFiles(bookFileIDs []int64) ([]*BookFile, error) {
+	return r.GetBookFilesContext(context.Background(), bookFileIDs)
+}
+
+// GetBookFilesContext returns the requested book files by their IDs.
+func (r *Readarr) GetBookFilesContext(ctx context.Context, bookFileIDs []int64) ([]*BookFile, error) {
+	var output []*BookFile
+
+	if len(bookFileIDs) == 0 {
+		return output, nil
+	}
+
+	req := starr.Request{
+		URI:   bpBookFile,
+		Query: url.Values{"bookFileIds": make([]string, len(bookFileIDs))},
+	}
+
+	for idx, fileID := range bookFileIDs {
+		req.Query["bookFileIds"][idx] = fmt.Sprint(fileID)
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetBookFile returns a single book file by its ID.
+func (r *Readarr) GetBookFile(bookFileID int64) (*BookFile, error) {
+	return r.GetBookFileContext(context.Background(), bookFileID)
+}
+
+// GetBookFileContext returns a single book file by its ID.
+func (r *Readarr) GetBookFileContext(ctx context.Context, bookFileID int64) (*BookFile, error) {
+	var output BookFile
+
+	req := starr.Request{URI: path.Join(bpBookFile, fmt.Sprint(bookFileID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateBookFile updates a book file.
+func (r *Readarr) UpdateBookFile(bookFile *BookFile) (*BookFile, error) {
+	return r.UpdateBookFileContext(context.Background(), bookFile)
+}
+
+// UpdateBookFileContext updates a book file.
+func (r *Readarr) UpdateBookFileContext(ctx context.Context, bookFile *BookFile) (*BookFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bookFile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpBookFile, err)
+	}
+
+	var output BookFile
+
+	req := starr.Request{URI: path.Join(bpBookFile, fmt.Sprint(bookFile.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateBookFilesQuality sets the quality on many book files at once.
+func (r *Readarr) UpdateBookFilesQuality(bookFileIDs []int64, quality *starr.Quality) error {
+	return r.UpdateBookFilesQualityContext(context.Background(), bookFileIDs, quality)
+}
+
+// UpdateBookFilesQualityContext sets the quality on many book files at once.
+func (r *Readarr) UpdateBookFilesQualityContext(ctx context.Context, bookFileIDs []int64, quality *starr.Quality) error {
+	postData := struct {
+		B []int64        `json:"bookFileIds"`
+		Q *starr.Quality `json:"quality"`
+	}{bookFileIDs, quality}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBookFile, err)
+	}
+
+	var output interface{}
+
+	req := starr.Request{URI: path.Join(bpBookFile, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteBookFile deletes a book file.
+func (r *Readarr) DeleteBookFile(bookFileID int64) error {
+	return r.DeleteBookFileContext(context.Background(), bookFileID)
+}
+
+// DeleteBookFileContext deletes a book file.
+func (r *Readarr) DeleteBookFileContext(ctx context.Context, bookFileID int64) error {
+	req := starr.Request{URI: path.Join(bpBookFile, fmt.Sprint(bookFileID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteBookFiles bulk deletes book files by their IDs.
+func (r *Readarr) DeleteBookFiles(bookFileIDs []int64) error {
+	return r.DeleteBookFilesContext(context.Background(), bookFileIDs)
+}
+
+// DeleteBookFilesContext bulk deletes book files by their IDs.
+func (r *Readarr) DeleteBookFilesContext(ctx context.Context, bookFileIDs []int64) error {
+	postData := struct {
+		B []int64 `json:"bookFileIds"`
+	}{bookFileIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBookFile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpBookFile, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
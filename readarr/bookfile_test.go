@@ -0,0 +1,102 @@
+package readarr_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const bookFileBody = `{"id": 5,"authorId": 1,"bookId": 2,"path": "/books/Author/Book/book.epub","size": 1024,` +
+	`"dateAdded": "2023-01-02T03:04:05Z","quality": {"quality": {"id": 3,"name": "EPUB"}},` +
+	`"qualityWeight": 300,"qualityCutoffNotMet": false}`
+
+func TestGetBookFilesForBook(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "bookfile?bookId=2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(2),
+			ResponseBody:   `[` + bookFileBody + `]`,
+			WithResponse: []*readarr.BookFile{{
+				ID:            5,
+				AuthorID:      1,
+				BookID:        2,
+				Path:          "/books/Author/Book/book.epub",
+				Size:          1024,
+				DateAdded:     time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				Quality:       &starr.Quality{Quality: &starr.BaseQuality{ID: 3, Name: "EPUB"}},
+				QualityWeight: 300,
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "bookfile?bookId=2"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(2),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*readarr.BookFile(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetBookFilesForBook(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBookFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookfile", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ResponseStatus:  200,
+			WithRequest:     []int64{5, 6},
+			ExpectedRequest: `{"bookFileIds":[5,6]}` + "\n",
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookfile", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ResponseStatus:  404,
+			WithRequest:     []int64{5, 6},
+			ExpectedRequest: `{"bookFileIds":[5,6]}` + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBookFiles(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
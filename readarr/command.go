@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"time"
 
 	"golift.io/starr"
@@ -15,9 +16,11 @@ const bpCommand = APIver + "/command"
 // CommandRequest goes into the /api/v1/command endpoint.
 // This was created from the search command and may not support other commands yet.
 type CommandRequest struct {
-	Name    string  `json:"name"`
-	BookIDs []int64 `json:"bookIds,omitempty"`
-	BookID  int64   `json:"bookId,omitempty"`
+	Name     string  `json:"name"`
+	BookIDs  []int64 `json:"bookIds,omitempty"`
+	BookID   int64   `json:"bookId,omitempty"`
+	AuthorID int64   `json:"authorId,omitempty"`
+	Files    []int64 `json:"files,omitempty"`
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -84,3 +87,64 @@ func (r *Readarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (
 
 	return &output, nil
 }
+
+// GetCommandStatus returns the status of an already started command.
+func (r *Readarr) GetCommandStatus(commandID int64) (*CommandResponse, error) {
+	return r.GetCommandStatusContext(context.Background(), commandID)
+}
+
+// GetCommandStatusContext returns the status of an already started command.
+func (r *Readarr) GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if commandID == 0 {
+		return &output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpCommand, fmt.Sprint(commandID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// WaitForCommand checks the status of a command every interval until it finishes.
+// Pass the response from SendCommand. Returns the final status of the command.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (r *Readarr) WaitForCommand(cmd *CommandResponse, interval time.Duration) (*CommandResponse, error) {
+	return r.WaitForCommandContext(context.Background(), cmd, interval)
+}
+
+// WaitForCommandContext checks the status of a command every interval until it finishes, or the context is cancelled.
+// Pass the response from SendCommand. Returns the last known status of the command.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (r *Readarr) WaitForCommandContext(
+	ctx context.Context,
+	cmd *CommandResponse,
+	interval time.Duration,
+) (*CommandResponse, error) {
+	if cmd == nil || cmd.ID == 0 {
+		return cmd, nil // nothing was sent.
+	}
+
+	output := cmd
+	first := true
+
+	err := starr.WaitForCommand(ctx, interval, func(ctx context.Context) (string, string, error) {
+		if !first {
+			status, err := r.GetCommandStatusContext(ctx, cmd.ID)
+			if err != nil {
+				return "", "", err
+			}
+
+			output = status
+		}
+
+		first = false
+
+		return output.Status, output.Message, nil
+	})
+
+	return output, err //nolint:wrapcheck // the error is already wrapped.
+}
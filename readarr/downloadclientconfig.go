@@ -23,6 +23,17 @@ type DownloadClientConfig struct {
 	RemoveFailedDownloads           bool   `json:"removeFailedDownloads"`
 }
 
+// DownloadClientConfigPatch contains the download client config fields to change with PatchDownloadClientConfig.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type DownloadClientConfigPatch struct {
+	EnableCompletedDownloadHandling *bool   `json:"enableCompletedDownloadHandling,omitempty"`
+	AutoRedownloadFailed            *bool   `json:"autoRedownloadFailed,omitempty"`
+	DownloadClientWorkingFolders    *string `json:"downloadClientWorkingFolders,omitempty"`
+	RemoveCompletedDownloads        *bool   `json:"removeCompletedDownloads,omitempty"`
+	RemoveFailedDownloads           *bool   `json:"removeFailedDownloads,omitempty"`
+}
+
 // GetDownloadClientConfig returns the download client config.
 func (r *Readarr) GetDownloadClientConfig() (*DownloadClientConfig, error) {
 	return r.GetDownloadClientConfigContext(context.Background())
@@ -63,3 +74,21 @@ func (r *Readarr) UpdateDownloadClientConfigContext(ctx context.Context,
 
 	return &output, nil
 }
+
+// PatchDownloadClientConfig changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (r *Readarr) PatchDownloadClientConfig(patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	return r.PatchDownloadClientConfigContext(context.Background(), patch)
+}
+
+// PatchDownloadClientConfigContext changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (r *Readarr) PatchDownloadClientConfigContext(ctx context.Context, patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	var output DownloadClientConfig
+
+	if err := starr.PatchConfig(ctx, r, bpDownloadClientConfig, true, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,68 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpEdition = APIver + "/edition"
+
+// ErrEditionNotFound is returned when a book does not have the requested edition.
+var ErrEditionNotFound = fmt.Errorf("book edition not found")
+
+// GetEditions returns the editions for a book.
+func (r *Readarr) GetEditions(bookID int64) ([]*Edition, error) {
+	return r.GetEditionsContext(context.Background(), bookID)
+}
+
+// GetEditionsContext returns the editions for a book.
+func (r *Readarr) GetEditionsContext(ctx context.Context, bookID int64) ([]*Edition, error) {
+	var output []*Edition
+
+	req := starr.Request{URI: bpEdition, Query: make(url.Values)}
+	req.Query.Add("bookId", fmt.Sprint(bookID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// MonitorBookEdition switches the monitored edition of a book to the edition with the provided
+// foreign (GRID) edition ID. Every other edition of the book is unmonitored.
+func (r *Readarr) MonitorBookEdition(bookID int64, foreignEditionID string) error {
+	return r.MonitorBookEditionContext(context.Background(), bookID, foreignEditionID)
+}
+
+// MonitorBookEditionContext switches the monitored edition of a book to the edition with the provided
+// foreign (GRID) edition ID. Every other edition of the book is unmonitored.
+func (r *Readarr) MonitorBookEditionContext(ctx context.Context, bookID int64, foreignEditionID string) error {
+	book, err := r.GetBookByIDContext(ctx, bookID)
+	if err != nil {
+		return err
+	}
+
+	if len(book.Editions) == 0 {
+		// The book endpoint does not always include editions.
+		if book.Editions, err = r.GetEditionsContext(ctx, bookID); err != nil {
+			return err
+		}
+	}
+
+	found := false
+
+	for _, edition := range book.Editions {
+		edition.Monitored = edition.ForeignEditionID == foreignEditionID
+		found = found || edition.Monitored
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %s", ErrEditionNotFound, foreignEditionID)
+	}
+
+	return r.UpdateBookContext(ctx, bookID, book, false)
+}
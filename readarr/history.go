@@ -3,7 +3,9 @@ package readarr
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -24,6 +26,8 @@ type History struct {
 
 // HistoryRecord is part of the history. Not all items have all Data members.
 // Check EventType for events you need.
+// The typed event accessors, like Grabbed, read data kept when the record is decoded from JSON.
+// They return empty results for a record built in Go.
 type HistoryRecord struct {
 	ID                  int64          `json:"id"`
 	BookID              int64          `json:"bookId"`
@@ -55,6 +59,7 @@ type HistoryRecord struct {
 		StatusMessages  string    `json:"statusMessages"`
 		TorrentInfoHash string    `json:"torrentInfoHash"`
 	} `json:"data"`
+	rawData json.RawMessage
 }
 
 // GetHistory returns the Readarr History (grabs/failures/completed).
@@ -141,3 +146,122 @@ func (r *Readarr) FailContext(ctx context.Context, historyID int64) error {
 
 	return nil
 }
+
+// GetAuthorHistory returns the history for an author, or one book from an author if bookID is not 0.
+// Pass a Filter constant for event to return only one event type, or FilterAll for all events.
+func (r *Readarr) GetAuthorHistory(authorID, bookID int64, event starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetAuthorHistoryContext(context.Background(), authorID, bookID, event)
+}
+
+// GetAuthorHistoryContext returns the history for an author, or one book from an author if bookID is not 0.
+// Pass a Filter constant for event to return only one event type, or FilterAll for all events.
+func (r *Readarr) GetAuthorHistoryContext(
+	ctx context.Context,
+	authorID, bookID int64,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "author"), Query: make(url.Values)}
+	req.Query.Add("authorId", fmt.Sprint(authorID))
+
+	if bookID != 0 {
+		req.Query.Add("bookId", fmt.Sprint(bookID))
+	}
+
+	return r.getHistoryRecords(ctx, req, event)
+}
+
+// GetHistorySince returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterAll for all events.
+func (r *Readarr) GetHistorySince(since time.Time, event starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetHistorySinceContext(context.Background(), since, event)
+}
+
+// GetHistorySinceContext returns every history record created after a point in time.
+// Use this to resume from a checkpoint without paging through the entire history.
+// Pass a Filter constant for event to return only one event type, or FilterAll for all events.
+func (r *Readarr) GetHistorySinceContext(
+	ctx context.Context,
+	since time.Time,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Add("date", since.UTC().Format(time.RFC3339))
+
+	return r.getHistoryRecords(ctx, req, event)
+}
+
+// getHistoryRecords gets a list of history records (not a page), optionally for a single event type.
+func (r *Readarr) getHistoryRecords(
+	ctx context.Context,
+	req starr.Request,
+	event starr.Filtering,
+) ([]*HistoryRecord, error) {
+	if event > 0 {
+		req.Query.Add("eventType", event.Param())
+	}
+
+	var output []*HistoryRecord
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UnmarshalJSON decodes a history record, and keeps a copy of the raw data for the typed event accessors.
+func (h *HistoryRecord) UnmarshalJSON(input []byte) error {
+	type record HistoryRecord // avoids recursion.
+
+	raw, err := starr.DecodeHistoryRecord(input, (*record)(h))
+	h.rawData = raw
+
+	return err //nolint:wrapcheck
+}
+
+// RawData returns every value from the record's data. The keys depend on the event type.
+func (h *HistoryRecord) RawData() (starr.HistoryData, error) {
+	return starr.ParseHistoryData(h.rawData)
+}
+
+// Event returns the record's event type and raw data.
+func (h *HistoryRecord) Event() starr.HistoryEvent {
+	return starr.HistoryEvent{Type: h.EventType, Raw: h.rawData}
+}
+
+// Grabbed returns the typed data from a grabbed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) Grabbed() (*starr.HistoryGrabbed, error) {
+	return h.Event().Grabbed() //nolint:wrapcheck
+}
+
+// BookFileImported returns the typed data from a book file imported history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) BookFileImported() (*starr.HistoryImported, error) {
+	return h.Event().Imported("bookFileImported") //nolint:wrapcheck
+}
+
+// DownloadFailed returns the typed data from a download failed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadFailed() (*starr.HistoryDownloadFailed, error) {
+	return h.Event().DownloadFailed() //nolint:wrapcheck
+}
+
+// BookFileDeleted returns the typed data from a book file deleted history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) BookFileDeleted() (*starr.HistoryFileDeleted, error) {
+	return h.Event().FileDeleted("bookFileDeleted") //nolint:wrapcheck
+}
+
+// BookFileRenamed returns the typed data from a book file renamed history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) BookFileRenamed() (*starr.HistoryFileRenamed, error) {
+	return h.Event().FileRenamed("bookFileRenamed") //nolint:wrapcheck
+}
+
+// DownloadIgnored returns the typed data from a download ignored history record.
+// Returns starr.ErrHistoryEvent if the record has a different event type.
+func (h *HistoryRecord) DownloadIgnored() (*starr.HistoryDownloadIgnored, error) {
+	return h.Event().DownloadIgnored() //nolint:wrapcheck
+}
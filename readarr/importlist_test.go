@@ -0,0 +1,145 @@
+package readarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const testImportList = `{"enableAutomaticAdd":true,"shouldMonitorExisting":false,"shouldSearch":true,` +
+	`"qualityProfileId":1,"metadataProfileId":1,"listOrder":0,"shouldMonitor":"entireAuthor",` +
+	`"monitorNewItems":"all","rootFolderPath":"/media","listType":"program","configContract":"ReadarrSettings",` +
+	`"implementation":"Readarr","name":"Other Readarr","tags":[],"fields":[{"name":"baseUrl","value":"http://other:1234"}]}`
+
+func importListInput() *readarr.ImportListInput {
+	return &readarr.ImportListInput{
+		EnableAutomaticAdd: true,
+		ShouldSearch:       true,
+		QualityProfileID:   1,
+		MetadataProfileID:  1,
+		ShouldMonitor:      "entireAuthor",
+		MonitorNewItems:    "all",
+		RootFolderPath:     "/media",
+		ListType:           "program",
+		ConfigContract:     "ReadarrSettings",
+		Implementation:     "Readarr",
+		Name:               "Other Readarr",
+		Tags:               []int{},
+		Fields:             []*starr.FieldInput{{Name: "baseUrl", Value: "http://other:1234"}},
+	}
+}
+
+func TestTestImportList(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "importList", "test"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     importListInput(),
+			ExpectedRequest: testImportList + "\n",
+			ResponseBody:    "{}",
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "importList", "test"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  400,
+			WithRequest:     importListInput(),
+			ExpectedRequest: testImportList + "\n",
+			ResponseBody: `[{"isWarning":false,"propertyName":"BaseUrl","errorMessage":"Unable to connect to Readarr",` +
+				`"severity":"error"}]`,
+			WithResponse: []*starr.ValidationFailure{
+				{
+					PropertyName: "BaseUrl",
+					ErrorMessage: "Unable to connect to Readarr",
+					Severity:     "error",
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "importList", "test"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     importListInput(),
+			ExpectedRequest: testImportList + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    ([]*starr.ValidationFailure)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestImportList(test.WithRequest.(*readarr.ImportListInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestAllImportLists(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "importList", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id":1,"isValid":true,"validationFailures":[]}]`,
+			WithResponse:   []*starr.ProviderTestResult{{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}}},
+			WithError:      nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "importList", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody: `[{"id":1,"isValid":true,"validationFailures":[]},{"id":2,"isValid":false,` +
+				`"validationFailures":[{"isWarning":false,"propertyName":"","errorMessage":"Unable to connect","severity":"error"}]}]`,
+			WithResponse: []*starr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: []*starr.ValidationFailure{}},
+				{ID: 2, IsValid: false, ValidationFailures: []*starr.ValidationFailure{
+					{ErrorMessage: "Unable to connect", Severity: "error"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "importList", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*starr.ProviderTestResult)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestAllImportLists()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for Naming calls.
+const bpNaming = APIver + "/config/naming"
+
+// Naming represents the config/naming endpoint in Readarr.
+type Naming struct {
+	RenameBooks              bool   `json:"renameBooks"`
+	ReplaceIllegalCharacters bool   `json:"replaceIllegalCharacters"`
+	IncludeAuthorName        bool   `json:"includeAuthorName"`
+	IncludeBookTitle         bool   `json:"includeBookTitle"`
+	IncludeQuality           bool   `json:"includeQuality"`
+	ReplaceSpaces            bool   `json:"replaceSpaces"`
+	ID                       int64  `json:"id"`
+	ColonReplacementFormat   int64  `json:"colonReplacementFormat"`
+	StandardBookFormat       string `json:"standardBookFormat"`
+	AuthorFolderFormat       string `json:"authorFolderFormat"`
+	Separator                string `json:"separator"`
+	NumberStyle              string `json:"numberStyle"`
+}
+
+// NamingPatch contains the naming config fields to change with PatchNaming.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type NamingPatch struct {
+	RenameBooks              *bool   `json:"renameBooks,omitempty"`
+	ReplaceIllegalCharacters *bool   `json:"replaceIllegalCharacters,omitempty"`
+	IncludeAuthorName        *bool   `json:"includeAuthorName,omitempty"`
+	IncludeBookTitle         *bool   `json:"includeBookTitle,omitempty"`
+	IncludeQuality           *bool   `json:"includeQuality,omitempty"`
+	ReplaceSpaces            *bool   `json:"replaceSpaces,omitempty"`
+	ColonReplacementFormat   *int64  `json:"colonReplacementFormat,omitempty"`
+	StandardBookFormat       *string `json:"standardBookFormat,omitempty"`
+	AuthorFolderFormat       *string `json:"authorFolderFormat,omitempty"`
+	Separator                *string `json:"separator,omitempty"`
+	NumberStyle              *string `json:"numberStyle,omitempty"`
+}
+
+// GetNaming returns the file naming rules.
+func (r *Readarr) GetNaming() (*Naming, error) {
+	return r.GetNamingContext(context.Background())
+}
+
+// GetNamingContext returns the file naming rules.
+func (r *Readarr) GetNamingContext(ctx context.Context) (*Naming, error) {
+	var output Naming
+
+	req := starr.Request{URI: bpNaming}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateNaming updates the file naming rules.
+func (r *Readarr) UpdateNaming(naming *Naming) (*Naming, error) {
+	return r.UpdateNamingContext(context.Background(), naming)
+}
+
+// UpdateNamingContext updates the file naming rules.
+func (r *Readarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Naming, error) {
+	var output Naming
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(naming); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpNaming, err)
+	}
+
+	req := starr.Request{URI: bpNaming, Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PatchNaming changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (r *Readarr) PatchNaming(patch *NamingPatch) (*Naming, error) {
+	return r.PatchNamingContext(context.Background(), patch)
+}
+
+// PatchNamingContext changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (r *Readarr) PatchNamingContext(ctx context.Context, patch *NamingPatch) (*Naming, error) {
+	var output Naming
+
+	if err := starr.PatchConfig(ctx, r, bpNaming, false, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
+
+// NamingExamples contains the sample file and folder names rendered from a naming config.
+type NamingExamples struct {
+	SingleBookExample    string `json:"singleBookExample"`
+	MultiPartBookExample string `json:"multiPartBookExample"`
+	AuthorFolderExample  string `json:"authorFolderExample"`
+}
+
+// namingTokens are the tokens Readarr accepts in naming formats. Some take a :format, like {Release Year:0000}.
+//
+//nolint:gochecknoglobals
+var namingTokens = []string{
+	"Author Name",
+	"Author CleanName",
+	"Author NameThe",
+	"Author CleanNameThe",
+	"Author SortName",
+	"Author NameFirstCharacter",
+	"Author Disambiguation",
+	"Book Title",
+	"Book CleanTitle",
+	"Book TitleThe",
+	"Book CleanTitleThe",
+	"Book TitleNoSub",
+	"Book CleanTitleNoSub",
+	"Book TitleTheNoSub",
+	"Book CleanTitleTheNoSub",
+	"Book Subtitle",
+	"Book Series",
+	"Book SeriesPosition",
+	"Book SeriesTitle",
+	"Book Disambiguation",
+	"Release Year",
+	"Release YearFirst",
+	"Edition Year",
+	"PartNumber",
+	"PartCount",
+	"Quality Proper",
+	"Quality Real",
+	"MediaInfo AudioCodec",
+	"MediaInfo AudioChannels",
+	"MediaInfo AudioBitRate",
+	"MediaInfo AudioBitsPerSample",
+	"MediaInfo AudioSampleRate",
+	"Quality Full",
+	"Quality Title",
+	"Release Group",
+	"Custom Formats",
+	"Custom Format",
+	"Original Title",
+	"Original Filename",
+}
+
+// GetNamingExamples renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (r *Readarr) GetNamingExamples(naming *Naming) (*NamingExamples, error) {
+	return r.GetNamingExamplesContext(context.Background(), naming)
+}
+
+// GetNamingExamplesContext renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (r *Readarr) GetNamingExamplesContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	query, err := starr.NamingQuery(naming)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped.
+	}
+
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: query}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// ValidateNamingFormat returns starr.ErrUnknownToken if a naming format contains a token Readarr does not support.
+// This check is local; it does not contact Readarr. An input like "{Book Title}/{Author Name} - {Book Title}" is valid.
+func ValidateNamingFormat(format string) error {
+	return starr.ValidateNamingFormat(format, namingTokens) //nolint:wrapcheck // the error is already wrapped.
+}
+
+// Validate checks every format in the naming config for unknown tokens. Empty formats are skipped.
+// Returns an error wrapping starr.ErrUnknownToken that names the first invalid format.
+func (n *Naming) Validate() error {
+	for _, format := range []struct{ name, format string }{
+		{"StandardBookFormat", n.StandardBookFormat},
+		{"AuthorFolderFormat", n.AuthorFolderFormat},
+	} {
+		if err := ValidateNamingFormat(format.format); err != nil {
+			return fmt.Errorf("%s: %w", format.name, err)
+		}
+	}
+
+	return nil
+}
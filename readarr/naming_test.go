@@ -0,0 +1,26 @@
+package readarr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+)
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{
+		// The Readarr defaults.
+		"{Book Title}/{Author Name} - {Book Title}{ (PartNumber)}",
+		"{Author Name}",
+		// A few other real formats.
+		`{Book Series}/{Book SeriesPosition:00} - {Book TitleNoSub} ({Release Year}` +
+			`)/{Author SortName} - {Book Title} {PartNumber:00} of {PartCount}`,
+	} {
+		assert.NoError(t, readarr.ValidateNamingFormat(format), format)
+	}
+
+	assert.ErrorIs(t, readarr.ValidateNamingFormat("{Release Year} {Release Yaer}"), starr.ErrUnknownToken)
+}
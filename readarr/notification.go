@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -169,3 +170,54 @@ func (r *Readarr) DeleteNotificationContext(ctx context.Context, notificationID
 
 	return nil
 }
+
+// TestNotification tests a notification's settings without saving it.
+// Validation failures reported by Readarr are returned as a list, not as an error.
+func (r *Readarr) TestNotification(notification *NotificationInput) ([]*starr.ValidationFailure, error) {
+	return r.TestNotificationContext(context.Background(), notification)
+}
+
+// TestNotificationContext tests a notification's settings without saving it.
+// Validation failures reported by Readarr are returned as a list, not as an error.
+func (r *Readarr) TestNotificationContext(ctx context.Context, notification *NotificationInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(notification); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpNotification, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpNotification, "test"), Body: &body}
+	if err := r.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllNotifications tests all configured notifications and returns the result for each.
+func (r *Readarr) TestAllNotifications() ([]*starr.ProviderTestResult, error) {
+	return r.TestAllNotificationsContext(context.Background())
+}
+
+// TestAllNotificationsContext tests all configured notifications and returns the result for each.
+func (r *Readarr) TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpNotification, "testall")}
+	if err := r.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,61 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is a file that does not match the naming config, from the /api/v1/rename endpoint.
+type Rename struct {
+	AuthorID     int64  `json:"authorId"`
+	BookID       int64  `json:"bookId"`
+	BookFileID   int64  `json:"bookFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// GetRename returns the existing and new path for each author file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (r *Readarr) GetRename(authorID int64) ([]*Rename, error) {
+	return r.GetRenameContext(context.Background(), authorID)
+}
+
+// GetRenameContext returns the existing and new path for each author file that does not match the naming config.
+// Use this to preview the changes RenameFiles makes.
+func (r *Readarr) GetRenameContext(ctx context.Context, authorID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Add("authorId", fmt.Sprint(authorID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RenameFiles renames files that belong to a author, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (r *Readarr) RenameFiles(authorID int64, fileIDs []int64) (*CommandResponse, error) {
+	return r.RenameFilesContext(context.Background(), authorID, fileIDs)
+}
+
+// RenameFilesContext renames files that belong to a author, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+// Cancel the context to stop waiting; the command keeps running in Readarr.
+func (r *Readarr) RenameFilesContext(ctx context.Context, authorID int64, fileIDs []int64) (*CommandResponse, error) {
+	cmd, err := r.SendCommandContext(ctx, &CommandRequest{Name: "RenameFiles", AuthorID: authorID, Files: fileIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.WaitForCommandContext(ctx, cmd, starr.DefaultCommandInterval)
+}
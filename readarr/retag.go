@@ -0,0 +1,65 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRetag = APIver + "/retag"
+
+// Retag is the /api/v1/retag endpoint. It is a preview of the tag changes for a book file.
+type Retag struct {
+	ID           int64            `json:"id"`
+	AuthorID     int64            `json:"authorId"`
+	BookID       int64            `json:"bookId"`
+	TrackNumbers []int            `json:"trackNumbers"`
+	BookFileID   int64            `json:"bookFileId"`
+	Path         string           `json:"path"`
+	Changes      []*TagDifference `json:"changes"`
+}
+
+// TagDifference is part of Retag.
+type TagDifference struct {
+	Field    string `json:"field"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// GetRetag returns a preview of the audio tag changes for the files of an author, or of one book if bookID is not 0.
+func (r *Readarr) GetRetag(authorID, bookID int64) ([]*Retag, error) {
+	return r.GetRetagContext(context.Background(), authorID, bookID)
+}
+
+// GetRetagContext returns a preview of the audio tag changes for the files of an author,
+// or of one book if bookID is not 0.
+func (r *Readarr) GetRetagContext(ctx context.Context, authorID, bookID int64) ([]*Retag, error) {
+	var output []*Retag
+
+	req := starr.Request{URI: bpRetag, Query: make(url.Values)}
+	req.Query.Add("authorId", fmt.Sprint(authorID))
+
+	if bookID != 0 {
+		req.Query.Add("bookId", fmt.Sprint(bookID))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RetagFiles writes the audio tags for the provided book files, belonging to an author.
+// Use GetRetag to find which files have tag changes. This starts a command, and returns its status.
+func (r *Readarr) RetagFiles(authorID int64, bookFileIDs []int64) (*CommandResponse, error) {
+	return r.RetagFilesContext(context.Background(), authorID, bookFileIDs)
+}
+
+// RetagFilesContext writes the audio tags for the provided book files, belonging to an author.
+// Use GetRetag to find which files have tag changes. This starts a command, and returns its status.
+func (r *Readarr) RetagFilesContext(ctx context.Context, authorID int64, bookFileIDs []int64) (*CommandResponse, error) {
+	return r.SendCommandContext(ctx, &CommandRequest{Name: "RetagFiles", AuthorID: authorID, Files: bookFileIDs})
+}
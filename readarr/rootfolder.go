@@ -1,8 +1,11 @@
 package readarr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
@@ -43,3 +46,79 @@ func (r *Readarr) GetRootFoldersContext(ctx context.Context) ([]*RootFolder, err
 
 	return output, nil
 }
+
+// GetRootFolder returns a single root folder.
+func (r *Readarr) GetRootFolder(folderID int64) (*RootFolder, error) {
+	return r.GetRootFolderContext(context.Background(), folderID)
+}
+
+// GetRootFolderContext returns a single root folder.
+func (r *Readarr) GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error) {
+	var output RootFolder
+
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folderID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddRootFolder creates a root folder.
+func (r *Readarr) AddRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return r.AddRootFolderContext(context.Background(), folder)
+}
+
+// AddRootFolderContext creates a root folder.
+func (r *Readarr) AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: bpRootFolder, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateRootFolder updates a root folder's name and defaults.
+func (r *Readarr) UpdateRootFolder(folder *RootFolder) (*RootFolder, error) {
+	return r.UpdateRootFolderContext(context.Background(), folder)
+}
+
+// UpdateRootFolderContext updates a root folder's name and defaults.
+func (r *Readarr) UpdateRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error) {
+	var output RootFolder
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(folder); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRootFolder, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folder.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteRootFolder removes a single root folder.
+func (r *Readarr) DeleteRootFolder(folderID int64) error {
+	return r.DeleteRootFolderContext(context.Background(), folderID)
+}
+
+// DeleteRootFolderContext removes a single root folder.
+func (r *Readarr) DeleteRootFolderContext(ctx context.Context, folderID int64) error {
+	req := starr.Request{URI: path.Join(bpRootFolder, fmt.Sprint(folderID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"golift.io/starr/prowlarr"
+)
+
+// ProwlarrState is the desired state of a Prowlarr instance.
+type ProwlarrState struct {
+	Tags            []string                                `json:"tags,omitempty" toml:"tags" xml:"tags" yaml:"tags,omitempty"`
+	DownloadClients []*Tagged[prowlarr.DownloadClientInput] `json:"downloadClients,omitempty" toml:"download_clients" xml:"download_clients" yaml:"downloadClients,omitempty"`
+	Indexers        []*Tagged[prowlarr.IndexerInput]        `json:"indexers,omitempty" toml:"indexers" xml:"indexers" yaml:"indexers,omitempty"`
+	Notifications   []*Tagged[prowlarr.NotificationInput]   `json:"notifications,omitempty" toml:"notifications" xml:"notifications" yaml:"notifications,omitempty"`
+}
+
+// allTags returns every tag label in the document, including those only referenced by items.
+func (s *ProwlarrState) allTags() []string {
+	labels := append([]string{}, s.Tags...)
+
+	for _, item := range s.DownloadClients {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Indexers {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Notifications {
+		labels = append(labels, item.Labels...)
+	}
+
+	return labels
+}
+
+// Plan reads the current state of a Prowlarr instance and returns the changes needed to match the desired state.
+// Nothing is changed until Apply() is called on the returned plan.
+func (s *ProwlarrState) Plan(ctx context.Context, app *prowlarr.Prowlarr, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := newPlan()
+
+	tags, err := app.GetTagsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	planTags(plan, opts, app, tags, s.allTags())
+
+	if err := s.planProviders(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	return plan.finish(), nil
+}
+
+// planProviders adds the download client, indexer and notification changes to a plan.
+func (s *ProwlarrState) planProviders(ctx context.Context, plan *Plan, opts *Options, app *prowlarr.Prowlarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	(&kind[*Tagged[prowlarr.DownloadClientInput], *prowlarr.DownloadClientOutput]{
+		name:    KindDownloadClient,
+		desired: s.DownloadClients,
+		current: clients,
+		key:     func(d *Tagged[prowlarr.DownloadClientInput]) string { return d.Item.Name },
+		curKey:  func(c *prowlarr.DownloadClientOutput) string { return c.Name },
+		curID:   func(c *prowlarr.DownloadClientOutput) int64 { return c.ID },
+		diff: func(d *Tagged[prowlarr.DownloadClientInput], c *prowlarr.DownloadClientOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[prowlarr.DownloadClientInput]) (int64, error) {
+			client := d.Item
+			client.ID = 0
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDownloadClientContext(ctx, &client)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[prowlarr.DownloadClientInput], cur *prowlarr.DownloadClientOutput) error {
+			client := d.Item
+			client.ID = cur.ID
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDownloadClientContext(ctx, &client)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDownloadClientContext,
+	}).plan(plan, opts)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	(&kind[*Tagged[prowlarr.IndexerInput], *prowlarr.IndexerOutput]{
+		name:    KindIndexer,
+		desired: s.Indexers,
+		current: indexers,
+		key:     func(d *Tagged[prowlarr.IndexerInput]) string { return d.Item.Name },
+		curKey:  func(c *prowlarr.IndexerOutput) string { return c.Name },
+		curID:   func(c *prowlarr.IndexerOutput) int64 { return c.ID },
+		diff: func(d *Tagged[prowlarr.IndexerInput], c *prowlarr.IndexerOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[prowlarr.IndexerInput]) (int64, error) {
+			indexer := d.Item
+			indexer.ID = 0
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddIndexerContext(ctx, &indexer)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[prowlarr.IndexerInput], cur *prowlarr.IndexerOutput) error {
+			indexer := d.Item
+			indexer.ID = cur.ID
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateIndexerContext(ctx, &indexer)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteIndexerContext,
+	}).plan(plan, opts)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	(&kind[*Tagged[prowlarr.NotificationInput], *prowlarr.NotificationOutput]{
+		name:    KindNotification,
+		desired: s.Notifications,
+		current: notifications,
+		key:     func(d *Tagged[prowlarr.NotificationInput]) string { return d.Item.Name },
+		curKey:  func(c *prowlarr.NotificationOutput) string { return c.Name },
+		curID:   func(c *prowlarr.NotificationOutput) int64 { return c.ID },
+		diff: func(d *Tagged[prowlarr.NotificationInput], c *prowlarr.NotificationOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[prowlarr.NotificationInput]) (int64, error) {
+			notification := d.Item
+			notification.ID = 0
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddNotificationContext(ctx, &notification)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[prowlarr.NotificationInput], cur *prowlarr.NotificationOutput) error {
+			notification := d.Item
+			notification.ID = cur.ID
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateNotificationContext(ctx, &notification)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteNotificationContext,
+	}).plan(plan, opts)
+
+	return nil
+}
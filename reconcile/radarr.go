@@ -0,0 +1,323 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"golift.io/starr/radarr"
+)
+
+// RadarrState is the desired state of a Radarr instance.
+// Quality profile format items may reference custom formats by name; their IDs are filled in for you.
+type RadarrState struct {
+	Tags            []string                              `json:"tags,omitempty" toml:"tags" xml:"tags" yaml:"tags,omitempty"`
+	CustomFormats   []*radarr.CustomFormatInput           `json:"customFormats,omitempty" toml:"custom_formats" xml:"custom_formats" yaml:"customFormats,omitempty"`
+	QualityProfiles []*radarr.QualityProfile              `json:"qualityProfiles,omitempty" toml:"quality_profiles" xml:"quality_profiles" yaml:"qualityProfiles,omitempty"`
+	RootFolders     []string                              `json:"rootFolders,omitempty" toml:"root_folders" xml:"root_folders" yaml:"rootFolders,omitempty"`
+	DelayProfiles   []*Tagged[radarr.DelayProfile]        `json:"delayProfiles,omitempty" toml:"delay_profiles" xml:"delay_profiles" yaml:"delayProfiles,omitempty"`
+	DownloadClients []*Tagged[radarr.DownloadClientInput] `json:"downloadClients,omitempty" toml:"download_clients" xml:"download_clients" yaml:"downloadClients,omitempty"`
+	Indexers        []*Tagged[radarr.IndexerInput]        `json:"indexers,omitempty" toml:"indexers" xml:"indexers" yaml:"indexers,omitempty"`
+	Notifications   []*Tagged[radarr.NotificationInput]   `json:"notifications,omitempty" toml:"notifications" xml:"notifications" yaml:"notifications,omitempty"`
+}
+
+// allTags returns every tag label in the document, including those only referenced by items.
+func (s *RadarrState) allTags() []string {
+	labels := append([]string{}, s.Tags...)
+
+	for _, item := range s.DelayProfiles {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.DownloadClients {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Indexers {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Notifications {
+		labels = append(labels, item.Labels...)
+	}
+
+	return labels
+}
+
+// Plan reads the current state of a Radarr instance and returns the changes needed to match the desired state.
+// Nothing is changed until Apply() is called on the returned plan.
+func (s *RadarrState) Plan(ctx context.Context, app *radarr.Radarr, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := newPlan()
+	if err := s.plan(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	return plan.finish(), nil
+}
+
+// plan adds the changes for the desired state to a plan.
+func (s *RadarrState) plan(ctx context.Context, plan *Plan, opts *Options, app *radarr.Radarr) error {
+	tags, err := app.GetTagsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting tags: %w", err)
+	}
+
+	planTags(plan, opts, app, tags, s.allTags())
+
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	(&kind[*radarr.CustomFormatInput, *radarr.CustomFormatOutput]{
+		name:    KindCustomFormat,
+		desired: s.CustomFormats,
+		current: formats,
+		key:     func(d *radarr.CustomFormatInput) string { return d.Name },
+		curKey:  func(c *radarr.CustomFormatOutput) string { return c.Name },
+		curID:   func(c *radarr.CustomFormatOutput) int64 { return c.ID },
+		diff: func(d *radarr.CustomFormatInput, c *radarr.CustomFormatOutput) []string {
+			return diffJSON(d, c, "id")
+		},
+		create: func(ctx context.Context, d *radarr.CustomFormatInput) (int64, error) {
+			format := *d
+			format.ID = 0
+			output, err := app.AddCustomFormatContext(ctx, &format)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *radarr.CustomFormatInput, cur *radarr.CustomFormatOutput) error {
+			format := *d
+			format.ID = cur.ID
+			_, err := app.UpdateCustomFormatContext(ctx, &format)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteCustomFormatContext,
+	}).plan(plan, opts)
+
+	profiles, err := app.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	(&kind[*radarr.QualityProfile, *radarr.QualityProfile]{
+		name:    KindQualityProfile,
+		desired: s.QualityProfiles,
+		current: profiles,
+		key:     func(d *radarr.QualityProfile) string { return d.Name },
+		curKey:  func(c *radarr.QualityProfile) string { return c.Name },
+		curID:   func(c *radarr.QualityProfile) int64 { return c.ID },
+		diff: func(d *radarr.QualityProfile, c *radarr.QualityProfile) []string {
+			profile := *d
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+
+			return diffJSON(&profile, c, "id")
+		},
+		create: func(ctx context.Context, d *radarr.QualityProfile) (int64, error) {
+			profile := *d
+			profile.ID = 0
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+			output, err := app.AddQualityProfileContext(ctx, &profile)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *radarr.QualityProfile, cur *radarr.QualityProfile) error {
+			profile := *d
+			profile.ID = cur.ID
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+			_, err := app.UpdateQualityProfileContext(ctx, &profile)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteQualityProfileContext,
+	}).plan(plan, opts)
+
+	folders, err := app.GetRootFoldersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting root folders: %w", err)
+	}
+
+	(&kind[string, *radarr.RootFolder]{
+		name:    KindRootFolder,
+		desired: s.RootFolders,
+		current: folders,
+		key:     func(d string) string { return d },
+		curKey:  func(c *radarr.RootFolder) string { return c.Path },
+		curID:   func(c *radarr.RootFolder) int64 { return c.ID },
+		diff:    func(string, *radarr.RootFolder) []string { return nil },
+		create: func(ctx context.Context, d string) (int64, error) {
+			output, err := app.AddRootFolderContext(ctx, &radarr.RootFolder{Path: d})
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		remove: app.DeleteRootFolderContext,
+	}).plan(plan, opts)
+
+	delays, err := app.GetDelayProfilesContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	(&kind[*Tagged[radarr.DelayProfile], *radarr.DelayProfile]{
+		name:    KindDelayProfile,
+		desired: s.DelayProfiles,
+		current: delays,
+		key:     func(d *Tagged[radarr.DelayProfile]) string { return delayKey(plan, d.Labels, d.Item.Tags) },
+		curKey:  func(c *radarr.DelayProfile) string { return delayKey(plan, nil, c.Tags) },
+		curID:   func(c *radarr.DelayProfile) int64 { return c.ID },
+		diff: func(d *Tagged[radarr.DelayProfile], c *radarr.DelayProfile) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[radarr.DelayProfile]) (int64, error) {
+			profile := d.Item
+			profile.ID = 0
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDelayProfileContext(ctx, &profile)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[radarr.DelayProfile], cur *radarr.DelayProfile) error {
+			profile := d.Item
+			profile.ID = cur.ID
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDelayProfileContext(ctx, &profile)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDelayProfileContext,
+	}).plan(plan, opts)
+
+	return s.planProviders(ctx, plan, opts, app)
+}
+
+// planProviders adds the download client, indexer and notification changes to a plan.
+func (s *RadarrState) planProviders(ctx context.Context, plan *Plan, opts *Options, app *radarr.Radarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	(&kind[*Tagged[radarr.DownloadClientInput], *radarr.DownloadClientOutput]{
+		name:    KindDownloadClient,
+		desired: s.DownloadClients,
+		current: clients,
+		key:     func(d *Tagged[radarr.DownloadClientInput]) string { return d.Item.Name },
+		curKey:  func(c *radarr.DownloadClientOutput) string { return c.Name },
+		curID:   func(c *radarr.DownloadClientOutput) int64 { return c.ID },
+		diff: func(d *Tagged[radarr.DownloadClientInput], c *radarr.DownloadClientOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[radarr.DownloadClientInput]) (int64, error) {
+			client := d.Item
+			client.ID = 0
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDownloadClientContext(ctx, &client)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[radarr.DownloadClientInput], cur *radarr.DownloadClientOutput) error {
+			client := d.Item
+			client.ID = cur.ID
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDownloadClientContext(ctx, &client)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDownloadClientContext,
+	}).plan(plan, opts)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	(&kind[*Tagged[radarr.IndexerInput], *radarr.IndexerOutput]{
+		name:    KindIndexer,
+		desired: s.Indexers,
+		current: indexers,
+		key:     func(d *Tagged[radarr.IndexerInput]) string { return d.Item.Name },
+		curKey:  func(c *radarr.IndexerOutput) string { return c.Name },
+		curID:   func(c *radarr.IndexerOutput) int64 { return c.ID },
+		diff: func(d *Tagged[radarr.IndexerInput], c *radarr.IndexerOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[radarr.IndexerInput]) (int64, error) {
+			indexer := d.Item
+			indexer.ID = 0
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddIndexerContext(ctx, &indexer)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[radarr.IndexerInput], cur *radarr.IndexerOutput) error {
+			indexer := d.Item
+			indexer.ID = cur.ID
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateIndexerContext(ctx, &indexer)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteIndexerContext,
+	}).plan(plan, opts)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	(&kind[*Tagged[radarr.NotificationInput], *radarr.NotificationOutput]{
+		name:    KindNotification,
+		desired: s.Notifications,
+		current: notifications,
+		key:     func(d *Tagged[radarr.NotificationInput]) string { return d.Item.Name },
+		curKey:  func(c *radarr.NotificationOutput) string { return c.Name },
+		curID:   func(c *radarr.NotificationOutput) int64 { return c.ID },
+		diff: func(d *Tagged[radarr.NotificationInput], c *radarr.NotificationOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[radarr.NotificationInput]) (int64, error) {
+			notification := d.Item
+			notification.ID = 0
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddNotificationContext(ctx, &notification)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[radarr.NotificationInput], cur *radarr.NotificationOutput) error {
+			notification := d.Item
+			notification.ID = cur.ID
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateNotificationContext(ctx, &notification)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteNotificationContext,
+	}).plan(plan, opts)
+
+	return nil
+}
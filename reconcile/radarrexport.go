@@ -0,0 +1,639 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+)
+
+// RadarrExportVersion is the version of the document created by ExportRadarr.
+// Import refuses documents with a newer version than this.
+const RadarrExportVersion = 1
+
+// Errors returned when importing an exported configuration.
+var (
+	ErrExportVersion = errors.New("unsupported export document version")
+	ErrExportApp     = errors.New("export document belongs to a different app")
+	// ErrMaskedFields is returned when an export still contains masked passwords or API keys.
+	ErrMaskedFields = errors.New("export contains masked secrets; fill them in before importing")
+	// ErrMissingReference is returned when an item references a tag or profile, by name, that does not exist.
+	ErrMissingReference = errors.New("referenced item does not exist")
+)
+
+// RadarrExport contains all of the configuration this library can read from a Radarr instance.
+// Create one with ExportRadarr, save it as JSON, and Import it into another instance.
+// Tags, custom formats and quality profiles are referenced by name, so their IDs may differ between instances.
+type RadarrExport struct {
+	Version              int                           `json:"version"`
+	App                  starr.App                     `json:"app"`
+	Exported             time.Time                     `json:"exported"`
+	Naming               *radarr.Naming                `json:"naming,omitempty"`
+	MediaManagement      *radarr.MediaManagement       `json:"mediaManagement,omitempty"`
+	IndexerConfig        *radarr.IndexerConfig         `json:"indexerConfig,omitempty"`
+	DownloadClientConfig *radarr.DownloadClientConfig  `json:"downloadClientConfig,omitempty"`
+	QualityDefinitions   []*radarr.QualityDefinition   `json:"qualityDefinitions,omitempty"`
+	Restrictions         []*Tagged[radarr.Restriction] `json:"restrictions,omitempty"`
+	RemotePathMappings   []*starr.RemotePathMapping    `json:"remotePathMappings,omitempty"`
+	ImportLists          []*RadarrImportList           `json:"importLists,omitempty"`
+	State                RadarrState                   `json:"state"`
+}
+
+// RadarrImportList is an exported import list. Tags and the quality profile are referenced by name.
+type RadarrImportList struct {
+	Tags           []string          `json:"tags,omitempty"`
+	QualityProfile string            `json:"qualityProfile,omitempty"`
+	Item           radarr.ImportList `json:"item"`
+}
+
+// ExportRadarr reads all of the configuration this library supports from a Radarr instance.
+func ExportRadarr(ctx context.Context, app *radarr.Radarr) (*RadarrExport, error) {
+	export := &RadarrExport{Version: RadarrExportVersion, App: starr.Radarr, Exported: time.Now().UTC()}
+
+	if err := export.exportConfig(ctx, app); err != nil {
+		return nil, err
+	}
+
+	tags, err := app.GetTagsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	labels := make(map[int]string)
+
+	for _, tag := range tags {
+		labels[tag.ID] = tag.Label
+		export.State.Tags = append(export.State.Tags, tag.Label)
+	}
+
+	profiles, err := export.exportProfiles(ctx, app, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := export.exportProviders(ctx, app, labels, profiles); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// exportConfig reads the configuration endpoints that always exist.
+func (e *RadarrExport) exportConfig(ctx context.Context, app *radarr.Radarr) error {
+	var err error
+
+	if e.Naming, err = app.GetNamingContext(ctx); err != nil {
+		return fmt.Errorf("getting naming: %w", err)
+	}
+
+	if e.MediaManagement, err = app.GetMediaManagementContext(ctx); err != nil {
+		return fmt.Errorf("getting media management: %w", err)
+	}
+
+	if e.IndexerConfig, err = app.GetIndexerConfigContext(ctx); err != nil {
+		return fmt.Errorf("getting indexer config: %w", err)
+	}
+
+	if e.DownloadClientConfig, err = app.GetDownloadClientConfigContext(ctx); err != nil {
+		return fmt.Errorf("getting download client config: %w", err)
+	}
+
+	if e.QualityDefinitions, err = app.GetQualityDefinitionsContext(ctx); err != nil {
+		return fmt.Errorf("getting quality definitions: %w", err)
+	}
+
+	if e.RemotePathMappings, err = app.GetRemotePathMappingsContext(ctx); err != nil {
+		return fmt.Errorf("getting remote path mappings: %w", err)
+	}
+
+	for _, mapping := range e.RemotePathMappings {
+		mapping.ID = 0
+	}
+
+	return nil
+}
+
+// exportProfiles reads custom formats, quality profiles, delay profiles, restrictions and root folders.
+// Returns the quality profile names, by ID, so they may be referenced by name.
+func (e *RadarrExport) exportProfiles(
+	ctx context.Context,
+	app *radarr.Radarr,
+	labels map[int]string,
+) (map[int64]string, error) {
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	for _, format := range formats {
+		var input radarr.CustomFormatInput
+		if err := convert(format, &input); err != nil {
+			return nil, err
+		}
+
+		e.State.CustomFormats = append(e.State.CustomFormats, &input)
+	}
+
+	if e.State.QualityProfiles, err = app.GetQualityProfilesContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	profiles := make(map[int64]string)
+
+	for _, profile := range e.State.QualityProfiles {
+		profiles[profile.ID] = profile.Name
+		profile.ID = 0
+
+		for _, item := range profile.FormatItems {
+			item.Format = 0 // Custom formats are found by name when importing.
+		}
+	}
+
+	folders, err := app.GetRootFoldersContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	for _, folder := range folders {
+		e.State.RootFolders = append(e.State.RootFolders, folder.Path)
+	}
+
+	delays, err := app.GetDelayProfilesContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	for _, delay := range delays {
+		item := &Tagged[radarr.DelayProfile]{Labels: toLabels(labels, delay.Tags)}
+		if err := convert(delay, &item.Item); err != nil {
+			return nil, err
+		}
+
+		e.State.DelayProfiles = append(e.State.DelayProfiles, item)
+	}
+
+	restrictions, err := app.GetRestrictionsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting restrictions: %w", err)
+	}
+
+	for _, restriction := range restrictions {
+		item := &Tagged[radarr.Restriction]{Labels: toLabels(labels, restriction.Tags)}
+		if err := convert(restriction, &item.Item); err != nil {
+			return nil, err
+		}
+
+		e.Restrictions = append(e.Restrictions, item)
+	}
+
+	return profiles, nil
+}
+
+// exportProviders reads download clients, indexers, notifications and import lists.
+func (e *RadarrExport) exportProviders(
+	ctx context.Context,
+	app *radarr.Radarr,
+	labels map[int]string,
+	profiles map[int64]string,
+) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	for _, client := range clients {
+		item := &Tagged[radarr.DownloadClientInput]{Labels: toLabels(labels, client.Tags)}
+		if err := convert(client, &item.Item); err != nil {
+			return err
+		}
+
+		e.State.DownloadClients = append(e.State.DownloadClients, item)
+	}
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	for _, indexer := range indexers {
+		item := &Tagged[radarr.IndexerInput]{Labels: toLabels(labels, indexer.Tags)}
+		if err := convert(indexer, &item.Item); err != nil {
+			return err
+		}
+
+		e.State.Indexers = append(e.State.Indexers, item)
+	}
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	for _, notification := range notifications {
+		item := &Tagged[radarr.NotificationInput]{Labels: toLabels(labels, notification.Tags)}
+		if err := convert(notification, &item.Item); err != nil {
+			return err
+		}
+
+		e.State.Notifications = append(e.State.Notifications, item)
+	}
+
+	lists, err := app.GetImportListsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting import lists: %w", err)
+	}
+
+	for _, list := range lists {
+		item := &RadarrImportList{Tags: toLabels(labels, list.Tags), QualityProfile: profiles[list.QualityProfileID]}
+		if err := convert(list, &item.Item); err != nil {
+			return err
+		}
+
+		item.Item.QualityProfileID = 0
+		e.ImportLists = append(e.ImportLists, item)
+	}
+
+	return nil
+}
+
+// MaskedFields lists the provider fields that Radarr masked when they were exported.
+// These are passwords and API keys; fill them in before importing. Import refuses an export with masked fields,
+// unless Options.AllowMasked is set.
+func (e *RadarrExport) MaskedFields() []string {
+	var output []string
+
+	for _, item := range e.State.DownloadClients {
+		output = append(output, maskedFields(KindDownloadClient, item.Item.Name, item.Item.Fields)...)
+	}
+
+	for _, item := range e.State.Indexers {
+		output = append(output, maskedFields(KindIndexer, item.Item.Name, item.Item.Fields)...)
+	}
+
+	for _, item := range e.State.Notifications {
+		output = append(output, maskedFields(KindNotification, item.Item.Name, item.Item.Fields)...)
+	}
+
+	for _, item := range e.ImportLists {
+		for _, field := range item.Item.Fields {
+			if field.Value == masked {
+				output = append(output, fmt.Sprintf("%s %q: %s", KindImportList, item.Item.Name, field.Name))
+			}
+		}
+	}
+
+	return output
+}
+
+func maskedFields(kind, name string, fields []*starr.FieldInput) []string {
+	var output []string
+
+	for _, field := range fields {
+		if field.Value == masked {
+			output = append(output, fmt.Sprintf("%s %q: %s", kind, name, field.Name))
+		}
+	}
+
+	return output
+}
+
+// Import compares the exported configuration with a Radarr instance and returns the changes needed to match it.
+// Nothing is changed until Apply() is called on the returned plan. With Options.Prune, items that exist in
+// the instance but not in the export are deleted. Returns ErrMaskedFields if MaskedFields() is not empty,
+// so a masked secret never overwrites a working one; set Options.AllowMasked to import it anyway.
+func (e *RadarrExport) Import(ctx context.Context, app *radarr.Radarr, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if e.App != starr.Radarr {
+		return nil, fmt.Errorf("%w: %s", ErrExportApp, e.App)
+	} else if e.Version > RadarrExportVersion {
+		return nil, fmt.Errorf("%w: %d", ErrExportVersion, e.Version)
+	} else if masked := e.MaskedFields(); len(masked) > 0 && !opts.AllowMasked {
+		return nil, fmt.Errorf("%w: %s", ErrMaskedFields, strings.Join(masked, ", "))
+	}
+
+	plan := newPlan()
+
+	if err := e.importConfig(ctx, plan, app); err != nil {
+		return nil, err
+	}
+
+	if err := e.State.plan(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	if err := e.importRestrictions(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	if err := e.importLists(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	return plan.finish(), nil
+}
+
+// importConfig plans updates for the configuration endpoints and quality definitions.
+func (e *RadarrExport) importConfig(ctx context.Context, plan *Plan, app *radarr.Radarr) error {
+	if e.Naming != nil {
+		have, err := app.GetNamingContext(ctx)
+		if err != nil {
+			return fmt.Errorf("getting naming: %w", err)
+		}
+
+		planConfig(plan, "naming", e.Naming, have, func(ctx context.Context) error {
+			want := *e.Naming
+			want.ID = have.ID
+			_, err := app.UpdateNamingContext(ctx, &want)
+
+			return err //nolint:wrapcheck
+		})
+	}
+
+	if e.MediaManagement != nil {
+		have, err := app.GetMediaManagementContext(ctx)
+		if err != nil {
+			return fmt.Errorf("getting media management: %w", err)
+		}
+
+		planConfig(plan, "mediaManagement", e.MediaManagement, have, func(ctx context.Context) error {
+			want := *e.MediaManagement
+			want.ID = have.ID
+			_, err := app.UpdateMediaManagementContext(ctx, &want)
+
+			return err //nolint:wrapcheck
+		})
+	}
+
+	if e.IndexerConfig != nil {
+		have, err := app.GetIndexerConfigContext(ctx)
+		if err != nil {
+			return fmt.Errorf("getting indexer config: %w", err)
+		}
+
+		planConfig(plan, "indexer", e.IndexerConfig, have, func(ctx context.Context) error {
+			want := *e.IndexerConfig
+			want.ID = have.ID
+			_, err := app.UpdateIndexerConfigContext(ctx, &want)
+
+			return err //nolint:wrapcheck
+		})
+	}
+
+	if e.DownloadClientConfig != nil {
+		have, err := app.GetDownloadClientConfigContext(ctx)
+		if err != nil {
+			return fmt.Errorf("getting download client config: %w", err)
+		}
+
+		planConfig(plan, "downloadClient", e.DownloadClientConfig, have, func(ctx context.Context) error {
+			want := *e.DownloadClientConfig
+			want.ID = have.ID
+			_, err := app.UpdateDownloadClientConfigContext(ctx, &want)
+
+			return err //nolint:wrapcheck
+		})
+	}
+
+	definitions, err := app.GetQualityDefinitionsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting quality definitions: %w", err)
+	}
+
+	(&kind[*radarr.QualityDefinition, *radarr.QualityDefinition]{
+		name:    KindQualityDefinition,
+		desired: e.QualityDefinitions,
+		current: definitions,
+		key:     qualityDefinitionName,
+		curKey:  qualityDefinitionName,
+		curID:   func(c *radarr.QualityDefinition) int64 { return c.ID },
+		diff: func(d *radarr.QualityDefinition, c *radarr.QualityDefinition) []string {
+			return diffJSON(d, c, "id")
+		},
+		update: func(ctx context.Context, d *radarr.QualityDefinition, cur *radarr.QualityDefinition) error {
+			definition := *d
+			definition.ID = cur.ID
+			_, err := app.UpdateQualityDefinitionContext(ctx, &definition)
+
+			return err //nolint:wrapcheck
+		},
+	}).plan(plan, &Options{})
+
+	return nil
+}
+
+// importRestrictions plans changes for restrictions and remote path mappings.
+func (e *RadarrExport) importRestrictions(ctx context.Context, plan *Plan, opts *Options, app *radarr.Radarr) error {
+	restrictions, err := app.GetRestrictionsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting restrictions: %w", err)
+	}
+
+	for _, restriction := range e.Restrictions {
+		name := restriction.Item.Required + " / " + restriction.Item.Ignored
+		if err := plan.checkTags(KindRestriction, name, restriction.Labels); err != nil {
+			return err
+		}
+	}
+
+	(&kind[*Tagged[radarr.Restriction], *radarr.Restriction]{
+		name:    KindRestriction,
+		desired: e.Restrictions,
+		current: restrictions,
+		key:     func(d *Tagged[radarr.Restriction]) string { return d.Item.Required + " / " + d.Item.Ignored },
+		curKey:  func(c *radarr.Restriction) string { return c.Required + " / " + c.Ignored },
+		curID:   func(c *radarr.Restriction) int64 { return c.ID },
+		diff: func(d *Tagged[radarr.Restriction], c *radarr.Restriction) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[radarr.Restriction]) (int64, error) {
+			restriction := d.Item
+			restriction.ID = 0
+			restriction.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddRestrictionContext(ctx, &restriction)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[radarr.Restriction], cur *radarr.Restriction) error {
+			restriction := d.Item
+			restriction.ID = cur.ID
+			restriction.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateRestrictionContext(ctx, &restriction)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteRestrictionContext,
+	}).plan(plan, opts)
+
+	mappings, err := app.GetRemotePathMappingsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting remote path mappings: %w", err)
+	}
+
+	(&kind[*starr.RemotePathMapping, *starr.RemotePathMapping]{
+		name:    KindRemotePathMapping,
+		desired: e.RemotePathMappings,
+		current: mappings,
+		key:     func(d *starr.RemotePathMapping) string { return d.Host + ":" + d.RemotePath },
+		curKey:  func(c *starr.RemotePathMapping) string { return c.Host + ":" + c.RemotePath },
+		curID:   func(c *starr.RemotePathMapping) int64 { return c.ID },
+		diff: func(d *starr.RemotePathMapping, c *starr.RemotePathMapping) []string {
+			return diffJSON(d, c, "id")
+		},
+		create: func(ctx context.Context, d *starr.RemotePathMapping) (int64, error) {
+			mapping := *d
+			mapping.ID = 0
+			output, err := app.AddRemotePathMappingContext(ctx, &mapping)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *starr.RemotePathMapping, cur *starr.RemotePathMapping) error {
+			mapping := *d
+			mapping.ID = cur.ID
+			_, err := app.UpdateRemotePathMappingContext(ctx, &mapping)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteRemotePathMappingContext,
+	}).plan(plan, opts)
+
+	return nil
+}
+
+// importLists plans changes for import lists. These reference quality profiles by name.
+func (e *RadarrExport) importLists(ctx context.Context, plan *Plan, opts *Options, app *radarr.Radarr) error {
+	lists, err := app.GetImportListsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting import lists: %w", err)
+	}
+
+	for _, list := range e.ImportLists {
+		if err := plan.checkTags(KindImportList, list.Item.Name, list.Tags); err != nil {
+			return err
+		}
+
+		if list.QualityProfile != "" && !plan.known(KindQualityProfile, list.QualityProfile) {
+			return fmt.Errorf("%w: %s %q: quality profile %q",
+				ErrMissingReference, KindImportList, list.Item.Name, list.QualityProfile)
+		}
+	}
+
+	// resolve converts tag labels and the quality profile name into IDs for this instance.
+	// cur is the existing import list, or nil for a new one.
+	resolve := func(d *RadarrImportList, cur *radarr.ImportList) *radarr.ImportList {
+		list := d.Item
+		list.ID, list.Tags = 0, plan.tagIDs(d.Tags, d.Item.Tags, nil)
+
+		if cur != nil {
+			list.ID, list.Tags = cur.ID, plan.tagIDs(d.Tags, d.Item.Tags, cur.Tags)
+		}
+
+		if d.QualityProfile != "" {
+			list.QualityProfileID = plan.id(KindQualityProfile, d.QualityProfile)
+		}
+
+		return &list
+	}
+
+	(&kind[*RadarrImportList, *radarr.ImportList]{
+		name:    KindImportList,
+		desired: e.ImportLists,
+		current: lists,
+		key:     func(d *RadarrImportList) string { return d.Item.Name },
+		curKey:  func(c *radarr.ImportList) string { return c.Name },
+		curID:   func(c *radarr.ImportList) int64 { return c.ID },
+		diff: func(d *RadarrImportList, c *radarr.ImportList) []string {
+			return diffJSON(resolve(d, c), c)
+		},
+		create: func(ctx context.Context, d *RadarrImportList) (int64, error) {
+			output, err := app.CreateImportListContext(ctx, resolve(d, nil))
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *RadarrImportList, cur *radarr.ImportList) error {
+			_, err := app.UpdateImportListContext(ctx, resolve(d, cur))
+			return err //nolint:wrapcheck
+		},
+		remove: func(ctx context.Context, id int64) error {
+			return app.DeleteImportListContext(ctx, []int64{id}) //nolint:wrapcheck
+		},
+	}).plan(plan, opts)
+
+	return nil
+}
+
+func qualityDefinitionName(definition *radarr.QualityDefinition) string {
+	if definition.Quality == nil {
+		return definition.Title
+	}
+
+	return definition.Quality.Name
+}
+
+// planConfig adds an update for a configuration endpoint that always exists, like naming.
+func planConfig(plan *Plan, name string, want, have interface{}, update func(context.Context) error) {
+	if diff := diffJSON(want, have, "id"); len(diff) > 0 {
+		plan.Changes = append(plan.Changes, &Change{
+			Kind: KindConfig, Name: name, Action: ActionUpdate, Diff: diff, apply: update,
+		})
+	}
+}
+
+// toLabels converts tag IDs into labels using a map of known tags.
+func toLabels(labels map[int]string, tags []int) []string {
+	output := []string{}
+
+	for _, tag := range tags {
+		if label, ok := labels[tag]; ok {
+			output = append(output, label)
+		}
+	}
+
+	return output
+}
+
+// convert copies an app's output type into its input type by way of JSON.
+// The ID is dropped, and tags are emptied; exported items reference tags by label.
+func convert(input, output interface{}) error {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	delete(generic, "id")
+
+	if _, ok := generic["tags"]; ok {
+		generic["tags"] = []int{}
+	}
+
+	if data, err = json.Marshal(generic); err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package reconcile_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/reconcile"
+)
+
+func TestRadarrExportImport(t *testing.T) {
+	t.Parallel()
+
+	source, _ := mockServer(t, map[string]string{
+		"config/mediaManagement": `{"id":1}`,
+		"config/downloadClient":  `{"id":1}`,
+		"config/indexer":         `{"id":1}`,
+		"config/naming":          `{"id":1,"renameMovies":true,"standardMovieFormat":"{Movie Title}","movieFolderFormat":"{Movie Title}"}`,
+		"tag":                    `[{"id":1,"label":"4k"}]`,
+		"customFormat":           `[{"id":4,"name":"x265","specifications":[]}]`,
+		"qualityProfile":         `[{"id":5,"name":"HD","cutoff":3,"formatItems":[{"format":4,"name":"x265","score":10}]}]`,
+		"indexer": `[{"id":3,"name":"NZBgeek","priority":25,"tags":[1],` +
+			`"fields":[{"name":"apiKey","value":"********"}]}]`,
+		"importlist": `[{"id":2,"name":"Trakt","qualityProfileId":5,"tags":[1],"fields":[]}]`,
+	})
+
+	export, err := reconcile.ExportRadarr(context.Background(), radarr.New(starr.New("mockAPIkey", source.URL, 0)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{`indexer "NZBgeek": apiKey`}, export.MaskedFields())
+
+	// Make sure the document survives a round trip.
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	var doc reconcile.RadarrExport
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	target, writes := mockServer(t, map[string]string{
+		"config/mediaManagement": `{"id":1}`,
+		"config/downloadClient":  `{"id":1}`,
+		"config/indexer":         `{"id":1}`,
+		"config/naming":          `{"id":1,"renameMovies":true,"standardMovieFormat":"{Movie Title}","movieFolderFormat":"{Movie Title}"}`,
+		"tag":                    `[{"id":7,"label":"4k"}]`,
+		"customFormat":           `[{"id":11,"name":"x265","specifications":[]}]`,
+	})
+
+	// A masked secret must not overwrite a working one; fill it in first.
+	_, err = doc.Import(context.Background(), radarr.New(starr.New("mockAPIkey", target.URL, 0)), nil)
+	require.ErrorIs(t, err, reconcile.ErrMaskedFields)
+	assert.Empty(t, *writes)
+
+	doc.State.Indexers[0].Item.Fields[0].Value = "real-key"
+	plan, err := doc.Import(context.Background(), radarr.New(starr.New("mockAPIkey", target.URL, 0)), nil)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3, plan.String())
+	assert.Equal(t, reconcile.KindQualityProfile, plan.Changes[0].Kind)
+	assert.Equal(t, reconcile.KindIndexer, plan.Changes[1].Kind)
+	assert.Equal(t, reconcile.KindImportList, plan.Changes[2].Kind)
+
+	require.NoError(t, plan.Apply(context.Background()))
+	require.Len(t, *writes, 3)
+	// The custom format, tag and quality profile IDs were remapped by name.
+	assert.True(t, strings.HasPrefix((*writes)[0], "POST /api/v3/qualityProfile "))
+	assert.Contains(t, (*writes)[0], `"formatItems":[{"format":11,"name":"x265","score":10}]`)
+	assert.Contains(t, (*writes)[1], `"tags":[7]`)
+	assert.Contains(t, (*writes)[1], `"real-key"`)
+	assert.NotContains(t, (*writes)[1], "********")
+	assert.Contains(t, (*writes)[2], `"qualityProfileId":9`)
+	assert.Contains(t, (*writes)[2], `"tags":[7]`)
+}
+
+func TestRadarrImportWrongApp(t *testing.T) {
+	t.Parallel()
+
+	export := &reconcile.RadarrExport{App: starr.Sonarr, Version: reconcile.RadarrExportVersion}
+	_, err := export.Import(context.Background(), radarr.New(starr.New("mockAPIkey", "http://127.0.0.1:1", 0)), nil)
+	assert.ErrorIs(t, err, reconcile.ErrExportApp)
+
+	export = &reconcile.RadarrExport{App: starr.Radarr, Version: reconcile.RadarrExportVersion + 1}
+	_, err = export.Import(context.Background(), radarr.New(starr.New("mockAPIkey", "http://127.0.0.1:1", 0)), nil)
+	assert.ErrorIs(t, err, reconcile.ErrExportVersion)
+}
+
+func TestRadarrImportMissingReference(t *testing.T) {
+	t.Parallel()
+
+	target, writes := mockServer(t, map[string]string{
+		"tag":            `[{"id":7,"label":"4k"}]`,
+		"qualityProfile": `[{"id":5,"name":"HD"}]`,
+	})
+	app := radarr.New(starr.New("mockAPIkey", target.URL, 0))
+
+	export := &reconcile.RadarrExport{
+		App:         starr.Radarr,
+		Version:     reconcile.RadarrExportVersion,
+		ImportLists: []*reconcile.RadarrImportList{{QualityProfile: "UHD", Item: radarr.ImportList{Name: "Trakt"}}},
+	}
+
+	_, err := export.Import(context.Background(), app, nil)
+	require.ErrorIs(t, err, reconcile.ErrMissingReference)
+	assert.Contains(t, err.Error(), `importList "Trakt": quality profile "UHD"`)
+
+	export.ImportLists[0] = &reconcile.RadarrImportList{
+		Tags: []string{"4K", "kids"}, QualityProfile: "HD", Item: radarr.ImportList{Name: "Trakt"},
+	}
+
+	_, err = export.Import(context.Background(), app, nil)
+	require.ErrorIs(t, err, reconcile.ErrMissingReference)
+	assert.Contains(t, err.Error(), `importList "Trakt": tag "kids"`)
+
+	// Tags and profiles the plan creates may be referenced.
+	export.State.Tags = []string{"kids"}
+	plan, err := export.Import(context.Background(), app, nil)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply(context.Background()))
+	assert.Contains(t, (*writes)[len(*writes)-1], `"qualityProfileId":5`)
+	assert.Contains(t, (*writes)[len(*writes)-1], `"tags":[7,9]`)
+}
+
+func TestRadarrImportMasked(t *testing.T) {
+	t.Parallel()
+
+	target, writes := mockServer(t, map[string]string{
+		"indexer": `[{"id":3,"name":"NZBgeek","priority":25,"fields":[{"name":"apiKey","value":"********"}]}]`,
+	})
+	app := radarr.New(starr.New("mockAPIkey", target.URL, 0))
+
+	export := &reconcile.RadarrExport{App: starr.Radarr, Version: reconcile.RadarrExportVersion}
+	export.State.Indexers = []*reconcile.Tagged[radarr.IndexerInput]{{Item: radarr.IndexerInput{
+		Name: "NZBgeek", Priority: 10, Fields: []*starr.FieldInput{{Name: "apiKey", Value: "********"}},
+	}}}
+
+	// The indexer's priority differs, but the update would overwrite the target's API key with asterisks.
+	_, err := export.Import(context.Background(), app, nil)
+	require.ErrorIs(t, err, reconcile.ErrMaskedFields)
+	assert.Contains(t, err.Error(), `indexer "NZBgeek": apiKey`)
+	assert.Empty(t, *writes, "nothing may be sent to the app")
+}
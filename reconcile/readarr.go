@@ -0,0 +1,284 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"golift.io/starr/readarr"
+)
+
+// ReadarrState is the desired state of a Readarr instance.
+// Root folders are matched by path. Their default tags are not compared, because tag IDs differ between instances.
+type ReadarrState struct {
+	Tags            []string                               `json:"tags,omitempty" toml:"tags" xml:"tags" yaml:"tags,omitempty"`
+	QualityProfiles []*readarr.QualityProfile              `json:"qualityProfiles,omitempty" toml:"quality_profiles" xml:"quality_profiles" yaml:"qualityProfiles,omitempty"`
+	RootFolders     []*readarr.RootFolder                  `json:"rootFolders,omitempty" toml:"root_folders" xml:"root_folders" yaml:"rootFolders,omitempty"`
+	DelayProfiles   []*Tagged[readarr.DelayProfile]        `json:"delayProfiles,omitempty" toml:"delay_profiles" xml:"delay_profiles" yaml:"delayProfiles,omitempty"`
+	DownloadClients []*Tagged[readarr.DownloadClientInput] `json:"downloadClients,omitempty" toml:"download_clients" xml:"download_clients" yaml:"downloadClients,omitempty"`
+	Indexers        []*Tagged[readarr.IndexerInput]        `json:"indexers,omitempty" toml:"indexers" xml:"indexers" yaml:"indexers,omitempty"`
+	Notifications   []*Tagged[readarr.NotificationInput]   `json:"notifications,omitempty" toml:"notifications" xml:"notifications" yaml:"notifications,omitempty"`
+}
+
+// allTags returns every tag label in the document, including those only referenced by items.
+func (s *ReadarrState) allTags() []string {
+	labels := append([]string{}, s.Tags...)
+
+	for _, item := range s.DelayProfiles {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.DownloadClients {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Indexers {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Notifications {
+		labels = append(labels, item.Labels...)
+	}
+
+	return labels
+}
+
+// Plan reads the current state of a Readarr instance and returns the changes needed to match the desired state.
+// Nothing is changed until Apply() is called on the returned plan.
+func (s *ReadarrState) Plan(ctx context.Context, app *readarr.Readarr, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := newPlan()
+
+	tags, err := app.GetTagsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	planTags(plan, opts, app, tags, s.allTags())
+
+	profiles, err := app.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	(&kind[*readarr.QualityProfile, *readarr.QualityProfile]{
+		name:    KindQualityProfile,
+		desired: s.QualityProfiles,
+		current: profiles,
+		key:     func(d *readarr.QualityProfile) string { return d.Name },
+		curKey:  func(c *readarr.QualityProfile) string { return c.Name },
+		curID:   func(c *readarr.QualityProfile) int64 { return c.ID },
+		diff: func(d *readarr.QualityProfile, c *readarr.QualityProfile) []string {
+			return diffJSON(d, c, "id")
+		},
+		create: func(ctx context.Context, d *readarr.QualityProfile) (int64, error) {
+			profile := *d
+			profile.ID = 0
+
+			return app.AddQualityProfileContext(ctx, &profile) //nolint:wrapcheck
+		},
+		update: func(ctx context.Context, d *readarr.QualityProfile, cur *readarr.QualityProfile) error {
+			profile := *d
+			profile.ID = cur.ID
+
+			return app.UpdateQualityProfileContext(ctx, &profile) //nolint:wrapcheck
+		},
+		remove: app.DeleteQualityProfileContext,
+	}).plan(plan, opts)
+
+	folders, err := app.GetRootFoldersContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	(&kind[*readarr.RootFolder, *readarr.RootFolder]{
+		name:    KindRootFolder,
+		desired: s.RootFolders,
+		current: folders,
+		key:     func(d *readarr.RootFolder) string { return d.Path },
+		curKey:  func(c *readarr.RootFolder) string { return c.Path },
+		curID:   func(c *readarr.RootFolder) int64 { return c.ID },
+		diff: func(d *readarr.RootFolder, c *readarr.RootFolder) []string {
+			return diffJSON(d, c, "id", "defaultTags", "accessible", "freeSpace", "totalSpace", "unmappedFolders")
+		},
+		create: func(ctx context.Context, d *readarr.RootFolder) (int64, error) {
+			folder := *d
+			folder.ID = 0
+			output, err := app.AddRootFolderContext(ctx, &folder)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *readarr.RootFolder, cur *readarr.RootFolder) error {
+			folder := *d
+			folder.ID = cur.ID
+			_, err := app.UpdateRootFolderContext(ctx, &folder)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteRootFolderContext,
+	}).plan(plan, opts)
+
+	delays, err := app.GetDelayProfilesContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	(&kind[*Tagged[readarr.DelayProfile], *readarr.DelayProfile]{
+		name:    KindDelayProfile,
+		desired: s.DelayProfiles,
+		current: delays,
+		key:     func(d *Tagged[readarr.DelayProfile]) string { return delayKey(plan, d.Labels, d.Item.Tags) },
+		curKey:  func(c *readarr.DelayProfile) string { return delayKey(plan, nil, c.Tags) },
+		curID:   func(c *readarr.DelayProfile) int64 { return c.ID },
+		diff: func(d *Tagged[readarr.DelayProfile], c *readarr.DelayProfile) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[readarr.DelayProfile]) (int64, error) {
+			profile := d.Item
+			profile.ID = 0
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDelayProfileContext(ctx, &profile)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[readarr.DelayProfile], cur *readarr.DelayProfile) error {
+			profile := d.Item
+			profile.ID = cur.ID
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDelayProfileContext(ctx, &profile)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDelayProfileContext,
+	}).plan(plan, opts)
+
+	if err := s.planProviders(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	return plan.finish(), nil
+}
+
+// planProviders adds the download client, indexer and notification changes to a plan.
+func (s *ReadarrState) planProviders(ctx context.Context, plan *Plan, opts *Options, app *readarr.Readarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	(&kind[*Tagged[readarr.DownloadClientInput], *readarr.DownloadClientOutput]{
+		name:    KindDownloadClient,
+		desired: s.DownloadClients,
+		current: clients,
+		key:     func(d *Tagged[readarr.DownloadClientInput]) string { return d.Item.Name },
+		curKey:  func(c *readarr.DownloadClientOutput) string { return c.Name },
+		curID:   func(c *readarr.DownloadClientOutput) int64 { return c.ID },
+		diff: func(d *Tagged[readarr.DownloadClientInput], c *readarr.DownloadClientOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[readarr.DownloadClientInput]) (int64, error) {
+			client := d.Item
+			client.ID = 0
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDownloadClientContext(ctx, &client)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[readarr.DownloadClientInput], cur *readarr.DownloadClientOutput) error {
+			client := d.Item
+			client.ID = cur.ID
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDownloadClientContext(ctx, &client)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDownloadClientContext,
+	}).plan(plan, opts)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	(&kind[*Tagged[readarr.IndexerInput], *readarr.IndexerOutput]{
+		name:    KindIndexer,
+		desired: s.Indexers,
+		current: indexers,
+		key:     func(d *Tagged[readarr.IndexerInput]) string { return d.Item.Name },
+		curKey:  func(c *readarr.IndexerOutput) string { return c.Name },
+		curID:   func(c *readarr.IndexerOutput) int64 { return c.ID },
+		diff: func(d *Tagged[readarr.IndexerInput], c *readarr.IndexerOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[readarr.IndexerInput]) (int64, error) {
+			indexer := d.Item
+			indexer.ID = 0
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddIndexerContext(ctx, &indexer)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[readarr.IndexerInput], cur *readarr.IndexerOutput) error {
+			indexer := d.Item
+			indexer.ID = cur.ID
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateIndexerContext(ctx, &indexer)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteIndexerContext,
+	}).plan(plan, opts)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	(&kind[*Tagged[readarr.NotificationInput], *readarr.NotificationOutput]{
+		name:    KindNotification,
+		desired: s.Notifications,
+		current: notifications,
+		key:     func(d *Tagged[readarr.NotificationInput]) string { return d.Item.Name },
+		curKey:  func(c *readarr.NotificationOutput) string { return c.Name },
+		curID:   func(c *readarr.NotificationOutput) int64 { return c.ID },
+		diff: func(d *Tagged[readarr.NotificationInput], c *readarr.NotificationOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[readarr.NotificationInput]) (int64, error) {
+			notification := d.Item
+			notification.ID = 0
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddNotificationContext(ctx, &notification)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[readarr.NotificationInput], cur *readarr.NotificationOutput) error {
+			notification := d.Item
+			notification.ID = cur.ID
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateNotificationContext(ctx, &notification)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteNotificationContext,
+	}).plan(plan, opts)
+
+	return nil
+}
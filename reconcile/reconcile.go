@@ -0,0 +1,555 @@
+// Package reconcile applies a desired-state document to a Starr application.
+// Describe the tags, profiles, providers and root folders an app should have,
+// then call Plan() on the app's state type to compare it with the running app.
+// The returned Plan may be printed as a dry-run diff, or applied with Apply().
+//
+// Items are matched by name (labels for tags, paths for root folders, tag labels
+// for delay profiles). Missing items are created, items that differ are updated,
+// and items that exist in the app but not in the document are deleted only when
+// Options.Prune is true. Changes are applied in dependency order: tags first, then
+// custom formats, quality profiles and everything that references them.
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// Action is the type of change planned for an item.
+type Action string
+
+// Actions a plan may contain.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Kinds of items the reconciler manages. These are used as Change.Kind values.
+const (
+	KindTag            = "tag"
+	KindCustomFormat   = "customFormat"
+	KindQualityProfile = "qualityProfile"
+	KindRootFolder     = "rootFolder"
+	KindDelayProfile   = "delayProfile"
+	KindDownloadClient = "downloadClient"
+	KindIndexer        = "indexer"
+	KindNotification   = "notification"
+	// These are only used when importing an exported configuration.
+	KindConfig            = "config"
+	KindQualityDefinition = "qualityDefinition"
+	KindRestriction       = "restriction"
+	KindRemotePathMapping = "remotePathMapping"
+	KindImportList        = "importList"
+)
+
+// masked is the value the apps return in place of passwords and API keys.
+const masked = "********"
+
+// Options modify how a plan is generated.
+type Options struct {
+	// Prune deletes items that exist in the app but are not in the desired state.
+	// Without this, the plan only creates and updates.
+	Prune bool
+	// AllowMasked lets an export with masked passwords and API keys be imported.
+	// The masked values are sent as-is, so only set this if the target app keeps
+	// its current value when it receives a masked one. See RadarrExport.MaskedFields.
+	AllowMasked bool
+}
+
+// Tagged wraps a desired item with the labels of the tags it should have.
+// The labels are converted to tag IDs when the plan is applied, and missing tags are created first.
+// If Labels is empty, the item's own Tags member is used as-is.
+type Tagged[T any] struct {
+	Labels []string `json:"tags,omitempty" toml:"tags" xml:"tags" yaml:"tags,omitempty"`
+	Item   T        `json:"item" toml:"item" xml:"item" yaml:"item"`
+}
+
+// Change is a single planned change to one item in a Starr app.
+type Change struct {
+	Kind   string   // Kind of item, ie. KindIndexer.
+	Name   string   // Name, label or path of the item.
+	Action Action   // What will happen to the item.
+	ID     int64    // ID of the existing item. Zero for creates.
+	Diff   []string // Differences between the app and the desired state. Updates only.
+	apply  func(ctx context.Context) error
+}
+
+// String turns a change into a single line (plus diff) for display.
+func (c *Change) String() string {
+	line := fmt.Sprintf("%s %s %q", c.Action, c.Kind, c.Name)
+	for _, diff := range c.Diff {
+		line += "\n    " + diff
+	}
+
+	return line
+}
+
+// Plan contains the changes needed to make an app match the desired state.
+// Changes are listed in the order they are applied.
+type Plan struct {
+	Changes []*Change
+	ids     map[string]map[string]int64 // kind -> name -> ID.
+	deletes []*Change                   // collected in kind order, applied in reverse.
+}
+
+func newPlan() *Plan {
+	return &Plan{ids: make(map[string]map[string]int64)}
+}
+
+// Empty returns true if the app already matches the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan as a human readable diff. Useful for a dry run.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "no changes"
+	}
+
+	lines := make([]string, len(p.Changes))
+	for idx, change := range p.Changes {
+		lines[idx] = change.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Apply makes the planned changes in order. It stops at the first error.
+// Newly created tags and custom formats are resolved by name for the changes that follow.
+func (p *Plan) Apply(ctx context.Context) error {
+	for _, change := range p.Changes {
+		if err := change.apply(ctx); err != nil {
+			return fmt.Errorf("%s %s %q: %w", change.Action, change.Kind, change.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// finish moves the collected deletes to the end of the plan, in reverse kind order.
+// This way items that reference tags are removed before the tags they reference.
+func (p *Plan) finish() *Plan {
+	for idx := len(p.deletes) - 1; idx >= 0; idx-- {
+		p.Changes = append(p.Changes, p.deletes[idx])
+	}
+
+	p.deletes = nil
+
+	return p
+}
+
+// setID stores the ID of a named item, so other items may reference it.
+func (p *Plan) setID(kind, name string, id int64) {
+	if p.ids[kind] == nil {
+		p.ids[kind] = make(map[string]int64)
+	}
+
+	p.ids[kind][name] = id
+}
+
+// id returns the ID of a named item, or 0 if it is unknown, or not created yet.
+func (p *Plan) id(kind, name string) int64 {
+	return p.ids[kind][name]
+}
+
+// known returns true if a named item exists, or the plan creates it.
+func (p *Plan) known(kind, name string) bool {
+	_, ok := p.ids[kind][name]
+	return ok
+}
+
+// checkTags returns ErrMissingReference if an item has a tag label that does not exist, and is not created by the plan.
+func (p *Plan) checkTags(kind, name string, labels []string) error {
+	for _, label := range labels {
+		if !p.known(KindTag, strings.ToLower(label)) {
+			return fmt.Errorf("%w: %s %q: tag %q", ErrMissingReference, kind, name, label)
+		}
+	}
+
+	return nil
+}
+
+// tagIDs converts tag labels into IDs. If there are no labels, the provided tags are returned.
+// If there are no provided tags either, tags are not managed, and the current item's tags are kept.
+// Pass nil for current when creating an item. The result is never nil, because some apps do not accept null tags.
+func (p *Plan) tagIDs(labels []string, tags, current []int) []int {
+	switch {
+	case len(labels) > 0:
+	case tags != nil:
+		return tags
+	case current != nil:
+		return current
+	default:
+		return []int{}
+	}
+
+	ids := make([]int, 0, len(labels))
+	for _, label := range labels {
+		if id := p.id(KindTag, strings.ToLower(label)); id != 0 {
+			ids = append(ids, int(id))
+		}
+	}
+
+	return ids
+}
+
+// tagLabels converts tag IDs into sorted labels.
+func (p *Plan) tagLabels(tags []int) []string {
+	labels := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		for label, id := range p.ids[KindTag] {
+			if id == int64(tag) {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+
+	sort.Strings(labels)
+
+	return labels
+}
+
+// labelKey turns a list of tag labels into a sorted, comma-separated key.
+func labelKey(labels []string) string {
+	output := make([]string, len(labels))
+	for idx, label := range labels {
+		output[idx] = strings.ToLower(label)
+	}
+
+	sort.Strings(output)
+
+	return strings.Join(output, ",")
+}
+
+// delayKey names a delay profile by its tag labels. The default delay profile has no tags, and no name.
+func delayKey(plan *Plan, labels []string, tags []int) string {
+	if len(labels) > 0 {
+		return labelKey(labels)
+	}
+
+	return labelKey(plan.tagLabels(tags))
+}
+
+// tagAPI is satisfied by every app package.
+type tagAPI interface {
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
+
+// planTags adds the tag changes to a plan. The apps store tag labels in lowercase.
+func planTags(plan *Plan, opts *Options, app tagAPI, current []*starr.Tag, labels []string) {
+	unique := make(map[string]bool)
+	desired := []string{}
+
+	for _, label := range labels {
+		if label = strings.ToLower(label); !unique[label] {
+			unique[label] = true
+			desired = append(desired, label)
+		}
+	}
+
+	(&kind[string, *starr.Tag]{
+		name:    KindTag,
+		desired: desired,
+		current: current,
+		key:     func(d string) string { return d },
+		curKey:  func(c *starr.Tag) string { return strings.ToLower(c.Label) },
+		curID:   func(c *starr.Tag) int64 { return int64(c.ID) },
+		diff:    func(string, *starr.Tag) []string { return nil },
+		create: func(ctx context.Context, d string) (int64, error) {
+			output, err := app.AddTagContext(ctx, &starr.Tag{Label: d})
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return int64(output.ID), nil
+		},
+		remove: func(ctx context.Context, id int64) error {
+			return app.DeleteTagContext(ctx, int(id)) //nolint:wrapcheck
+		},
+	}).plan(plan, opts)
+}
+
+// formatItems fills in custom format IDs for format items that only have a name.
+func (p *Plan) formatItems(items []*starr.FormatItem) []*starr.FormatItem {
+	if items == nil {
+		return nil
+	}
+
+	output := make([]*starr.FormatItem, len(items))
+
+	for idx, item := range items {
+		item := *item
+		if id := p.id(KindCustomFormat, item.Name); item.Format == 0 && id != 0 {
+			item.Format = id
+		}
+
+		output[idx] = &item
+	}
+
+	return output
+}
+
+// kind describes how to read, compare and write one type of item.
+// D is the desired type, C is the type the app returns.
+type kind[D, C any] struct {
+	name    string
+	desired []D
+	current []C
+	key     func(D) string                          // Name of a desired item.
+	curKey  func(C) string                          // Name of an existing item. Empty names are never deleted.
+	curID   func(C) int64                           // ID of an existing item.
+	diff    func(D, C) []string                     // Returns nothing if the items match.
+	create  func(context.Context, D) (int64, error) // nil if the item cannot be created.
+	update  func(context.Context, D, C) error       // nil if the item cannot be updated. Gets the existing item.
+	remove  func(context.Context, int64) error      // nil if the item cannot be deleted.
+}
+
+// plan adds the changes for this kind of item to a plan.
+func (k *kind[D, C]) plan(plan *Plan, opts *Options) {
+	existing := make(map[string]C)
+
+	for _, cur := range k.current {
+		name := k.curKey(cur)
+		existing[name] = cur
+		plan.setID(k.name, name, k.curID(cur))
+	}
+
+	wanted := make(map[string]bool)
+
+	for _, want := range k.desired {
+		want, name := want, k.key(want)
+		wanted[name] = true
+
+		cur, ok := existing[name]
+		if !ok && k.create == nil {
+			continue
+		} else if !ok {
+			plan.setID(k.name, name, 0) // the ID is set when the item is created.
+			plan.Changes = append(plan.Changes, &Change{
+				Kind: k.name, Name: name, Action: ActionCreate,
+				apply: func(ctx context.Context) error {
+					id, err := k.create(ctx, want)
+					if err == nil {
+						plan.setID(k.name, name, id)
+					}
+
+					return err
+				},
+			})
+
+			continue
+		}
+
+		diff := k.diff(want, cur)
+		if len(diff) == 0 || k.update == nil {
+			continue
+		}
+
+		id := k.curID(cur)
+		plan.Changes = append(plan.Changes, &Change{
+			Kind: k.name, Name: name, Action: ActionUpdate, ID: id, Diff: diff,
+			apply: func(ctx context.Context) error { return k.update(ctx, want, cur) },
+		})
+	}
+
+	if !opts.Prune {
+		return
+	}
+
+	for _, cur := range k.current {
+		name, id := k.curKey(cur), k.curID(cur)
+		if name == "" || wanted[name] || k.remove == nil {
+			continue
+		}
+
+		plan.deletes = append(plan.deletes, &Change{
+			Kind: k.name, Name: name, Action: ActionDelete, ID: id,
+			apply: func(ctx context.Context) error { return k.remove(ctx, id) },
+		})
+	}
+}
+
+// diffTagged compares a tagged desired item with an existing item.
+// Tags are compared by label, so the IDs may differ between instances.
+func diffTagged[T any](plan *Plan, want *Tagged[T], cur interface{}, curTags []int) []string {
+	diff := diffJSON(want.Item, cur, "id", "tags")
+	need := labelKey(want.Labels)
+
+	if len(want.Labels) == 0 {
+		if tags := tagsOf(want.Item); tags != nil {
+			need = labelKey(plan.tagLabels(tags))
+		} else {
+			return diff // tags are not managed for this item.
+		}
+	}
+
+	if have := labelKey(plan.tagLabels(curTags)); have != need {
+		diff = append(diff, fmt.Sprintf("tags: [%s] -> [%s]", have, need))
+	}
+
+	return diff
+}
+
+// tagsOf returns the tag IDs from any item with a tags member.
+func tagsOf(item interface{}) []int {
+	var tagged struct {
+		Tags []int `json:"tags"`
+	}
+
+	data, _ := json.Marshal(item)
+	_ = json.Unmarshal(data, &tagged)
+
+	return tagged.Tags
+}
+
+// diffJSON compares the JSON representation of a desired item with an existing item.
+// Only the members present in the desired item are compared, so output-only members
+// (like implementationName) are ignored. Lists of objects with a "name" member are
+// matched by name, so a desired list of fields only needs to contain the fields it sets.
+func diffJSON(want, have interface{}, ignore ...string) []string {
+	wantVal, err := toGeneric(want)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	haveVal, err := toGeneric(have)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	wantMap, _ := wantVal.(map[string]interface{})
+	for _, key := range ignore {
+		delete(wantMap, key)
+	}
+
+	var diff []string
+
+	compare("", wantVal, haveVal, &diff)
+	sort.Strings(diff)
+
+	return diff
+}
+
+func toGeneric(input interface{}) (interface{}, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	var output interface{}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return output, nil
+}
+
+// compare walks a desired value and records where the existing value differs.
+func compare(path string, want, have interface{}, diff *[]string) {
+	switch wantVal := want.(type) {
+	case map[string]interface{}:
+		haveMap, _ := have.(map[string]interface{})
+		for key, val := range wantVal {
+			compare(joinPath(path, key), val, lookup(haveMap, key), diff)
+		}
+	case []interface{}:
+		haveList, _ := have.([]interface{})
+		if named(wantVal) && named(haveList) {
+			compareNamed(path, wantVal, haveList, diff)
+			return
+		}
+
+		if len(wantVal) != len(haveList) {
+			*diff = append(*diff, fmt.Sprintf("%s: %s -> %s", path, toString(have), toString(want)))
+			return
+		}
+
+		for idx := range wantVal {
+			compare(fmt.Sprintf("%s[%d]", path, idx), wantVal[idx], haveList[idx], diff)
+		}
+	default:
+		if have == masked {
+			return // The app hides secrets, so we cannot compare them.
+		}
+
+		if !reflect.DeepEqual(want, have) {
+			*diff = append(*diff, fmt.Sprintf("%s: %s -> %s", path, toString(have), toString(want)))
+		}
+	}
+}
+
+// lookup finds a key in a map. The apps are not consistent with capitalization, so this is case-insensitive.
+func lookup(input map[string]interface{}, key string) interface{} {
+	if val, ok := input[key]; ok {
+		return val
+	}
+
+	for name, val := range input {
+		if strings.EqualFold(name, key) {
+			return val
+		}
+	}
+
+	return nil
+}
+
+// compareNamed compares lists of objects that have a name, like provider fields.
+func compareNamed(path string, want, have []interface{}, diff *[]string) {
+	haveByName := make(map[string]interface{})
+
+	for _, item := range have {
+		obj, _ := item.(map[string]interface{})
+		haveByName[fmt.Sprint(obj["name"])] = obj
+	}
+
+	for _, item := range want {
+		obj, _ := item.(map[string]interface{})
+		name := fmt.Sprint(obj["name"])
+		compare(fmt.Sprintf("%s[%s]", path, name), obj, haveByName[name], diff)
+	}
+}
+
+// named returns true if every item in a list is an object with a name.
+func named(list []interface{}) bool {
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if _, ok := obj["name"].(string); !ok {
+			return false
+		}
+	}
+
+	return len(list) > 0
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func toString(val interface{}) string {
+	if val == nil {
+		return "<unset>"
+	}
+
+	data, _ := json.Marshal(val)
+
+	return string(data)
+}
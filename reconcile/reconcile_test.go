@@ -0,0 +1,188 @@
+package reconcile_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/reconcile"
+)
+
+// mockServer returns canned GET responses, by path without the API version, and records every write request.
+func mockServer(t *testing.T, responses map[string]string) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		writes []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			body, _ := io.ReadAll(req.Body)
+
+			mu.Lock()
+			writes = append(writes, req.Method+" "+req.URL.Path+" "+string(body))
+			mu.Unlock()
+
+			_, _ = writer.Write([]byte(`{"id": 9}`))
+
+			return
+		}
+
+		_, uri, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/api/"), "/")
+
+		resp, ok := responses[uri]
+		if !ok {
+			resp = "[]"
+		}
+
+		_, _ = writer.Write([]byte(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &writes
+}
+
+func TestRadarrPlan(t *testing.T) {
+	t.Parallel()
+
+	server, writes := mockServer(t, map[string]string{
+		"tag":          `[{"id":1,"label":"movies"},{"id":2,"label":"old"}]`,
+		"customFormat": `[{"id":4,"name":"x265","includeCustomFormatWhenRenaming":false,"specifications":[]}]`,
+		"indexer": `[{"id":3,"name":"NZBgeek","enableRss":true,"priority":25,"tags":[1],` +
+			`"fields":[{"name":"baseUrl","value":"https://api.nzbgeek.info","label":"URL"},` +
+			`{"name":"apiKey","value":"********"}]}]`,
+	})
+
+	desired := &reconcile.RadarrState{
+		CustomFormats: []*radarr.CustomFormatInput{{Name: "x265", Specifications: []*radarr.CustomFormatInputSpec{}}},
+		Indexers: []*reconcile.Tagged[radarr.IndexerInput]{{
+			Labels: []string{"Movies", "4k"},
+			Item: radarr.IndexerInput{
+				Name:      "NZBgeek",
+				EnableRss: true,
+				Priority:  10,
+				Fields: []*starr.FieldInput{
+					{Name: "baseUrl", Value: "https://api.nzbgeek.info"},
+					{Name: "apiKey", Value: "secret"},
+				},
+			},
+		}},
+	}
+
+	app := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	plan, err := desired.Plan(context.Background(), app, &reconcile.Options{Prune: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3, plan.String())
+
+	assert.Equal(t, reconcile.ActionCreate, plan.Changes[0].Action)
+	assert.Equal(t, "4k", plan.Changes[0].Name)
+	assert.Equal(t, reconcile.ActionUpdate, plan.Changes[1].Action)
+	assert.Equal(t, reconcile.KindIndexer, plan.Changes[1].Kind)
+	assert.Equal(t, int64(3), plan.Changes[1].ID)
+	// The masked API key, and members only in the output (like labels) are not compared.
+	assert.Equal(t, []string{"priority: 25 -> 10", "tags: [movies] -> [4k,movies]"}, plan.Changes[1].Diff)
+	// Deletes go last, and the unused tag is deleted after the items that reference it.
+	assert.Equal(t, reconcile.ActionDelete, plan.Changes[2].Action)
+	assert.Equal(t, "old", plan.Changes[2].Name)
+
+	require.NoError(t, plan.Apply(context.Background()))
+	require.Len(t, *writes, 3)
+	assert.Equal(t, `POST /api/v3/tag {"label":"4k"}`+"\n", (*writes)[0])
+	// The new tag (9) is resolved by the time the indexer is updated.
+	assert.Contains(t, (*writes)[1], `PUT /api/v3/indexer/3 `)
+	assert.Contains(t, (*writes)[1], `"tags":[1,9]`)
+	assert.Equal(t, "DELETE /api/v3/tag/2 ", (*writes)[2])
+}
+
+func TestRadarrPlanNoChanges(t *testing.T) {
+	t.Parallel()
+
+	server, _ := mockServer(t, map[string]string{
+		"tag":        `[{"id":1,"label":"movies"}]`,
+		"rootFolder": `[{"id":1,"path":"/movies","freeSpace":1234}]`,
+	})
+
+	desired := &reconcile.RadarrState{Tags: []string{"movies"}, RootFolders: []string{"/movies"}}
+	app := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	plan, err := desired.Plan(context.Background(), app, nil)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+	assert.Equal(t, "no changes", plan.String())
+}
+
+func TestLidarrPlan(t *testing.T) {
+	t.Parallel()
+
+	server, writes := mockServer(t, map[string]string{
+		"tag": `[{"id":1,"label":"music"}]`,
+		"rootFolder": `[{"id":1,"name":"Music","path":"/music","defaultQualityProfileId":2,"freeSpace":1234},` +
+			`{"id":2,"name":"Old","path":"/old"}]`,
+		"delayprofile": `[{"id":1,"enableUsenet":true,"order":2147483647,"tags":[]}]`,
+	})
+
+	desired := &reconcile.LidarrState{
+		RootFolders: []*lidarr.RootFolder{{Name: "Music", Path: "/music", DefaultQualityProfileID: 1}},
+		DelayProfiles: []*reconcile.Tagged[lidarr.DelayProfile]{
+			{Item: lidarr.DelayProfile{EnableUsenet: true, Order: 2147483647}},
+			{Labels: []string{"music"}, Item: lidarr.DelayProfile{EnableTorrent: true, TorrentDelay: 60}},
+		},
+	}
+
+	app := lidarr.New(starr.New("mockAPIkey", server.URL, 0))
+	plan, err := desired.Plan(context.Background(), app, &reconcile.Options{Prune: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3, plan.String())
+
+	assert.Equal(t, reconcile.ActionUpdate, plan.Changes[0].Action)
+	assert.Equal(t, reconcile.KindRootFolder, plan.Changes[0].Kind)
+	// Space and other read-only members are not compared.
+	assert.Equal(t, []string{"defaultQualityProfileId: 2 -> 1"}, plan.Changes[0].Diff)
+	assert.Equal(t, reconcile.ActionCreate, plan.Changes[1].Action)
+	assert.Equal(t, reconcile.KindDelayProfile, plan.Changes[1].Kind)
+	assert.Equal(t, reconcile.ActionDelete, plan.Changes[2].Action)
+	assert.Equal(t, "/old", plan.Changes[2].Name)
+
+	require.NoError(t, plan.Apply(context.Background()))
+	require.Len(t, *writes, 3)
+	assert.Contains(t, (*writes)[0], `PUT /api/v1/rootFolder/1 `)
+	assert.Contains(t, (*writes)[1], `POST /api/v1/delayprofile `)
+	assert.Contains(t, (*writes)[1], `"tags":[1]`)
+	assert.Equal(t, "DELETE /api/v1/rootFolder/2 ", (*writes)[2])
+}
+
+func TestRadarrPlanUnmanagedTags(t *testing.T) {
+	t.Parallel()
+
+	server, writes := mockServer(t, map[string]string{
+		"tag":     `[{"id":1,"label":"movies"},{"id":2,"label":"4k"}]`,
+		"indexer": `[{"id":3,"name":"NZBgeek","priority":25,"tags":[1,2]}]`,
+	})
+
+	// No labels and no tags: the plan leaves the indexer's tags alone, and so does the update.
+	desired := &reconcile.RadarrState{
+		Tags:     []string{"movies", "4k"},
+		Indexers: []*reconcile.Tagged[radarr.IndexerInput]{{Item: radarr.IndexerInput{Name: "NZBgeek", Priority: 10}}},
+	}
+
+	app := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	plan, err := desired.Plan(context.Background(), app, nil)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1, plan.String())
+	assert.Equal(t, []string{"priority: 25 -> 10"}, plan.Changes[0].Diff)
+
+	require.NoError(t, plan.Apply(context.Background()))
+	require.Len(t, *writes, 1)
+	assert.Contains(t, (*writes)[0], `PUT /api/v3/indexer/3 `)
+	assert.Contains(t, (*writes)[0], `"tags":[1,2]`)
+}
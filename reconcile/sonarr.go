@@ -0,0 +1,321 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"golift.io/starr/sonarr"
+)
+
+// SonarrState is the desired state of a Sonarr instance.
+// Custom formats and quality profile format items only work with Sonarr v4.
+// Quality profile format items may reference custom formats by name; their IDs are filled in for you.
+type SonarrState struct {
+	Tags            []string                              `json:"tags,omitempty" toml:"tags" xml:"tags" yaml:"tags,omitempty"`
+	CustomFormats   []*sonarr.CustomFormat                `json:"customFormats,omitempty" toml:"custom_formats" xml:"custom_formats" yaml:"customFormats,omitempty"`
+	QualityProfiles []*sonarr.QualityProfile              `json:"qualityProfiles,omitempty" toml:"quality_profiles" xml:"quality_profiles" yaml:"qualityProfiles,omitempty"`
+	RootFolders     []string                              `json:"rootFolders,omitempty" toml:"root_folders" xml:"root_folders" yaml:"rootFolders,omitempty"`
+	DelayProfiles   []*Tagged[sonarr.DelayProfile]        `json:"delayProfiles,omitempty" toml:"delay_profiles" xml:"delay_profiles" yaml:"delayProfiles,omitempty"`
+	DownloadClients []*Tagged[sonarr.DownloadClientInput] `json:"downloadClients,omitempty" toml:"download_clients" xml:"download_clients" yaml:"downloadClients,omitempty"`
+	Indexers        []*Tagged[sonarr.IndexerInput]        `json:"indexers,omitempty" toml:"indexers" xml:"indexers" yaml:"indexers,omitempty"`
+	Notifications   []*Tagged[sonarr.NotificationInput]   `json:"notifications,omitempty" toml:"notifications" xml:"notifications" yaml:"notifications,omitempty"`
+}
+
+// allTags returns every tag label in the document, including those only referenced by items.
+func (s *SonarrState) allTags() []string {
+	labels := append([]string{}, s.Tags...)
+
+	for _, item := range s.DelayProfiles {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.DownloadClients {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Indexers {
+		labels = append(labels, item.Labels...)
+	}
+
+	for _, item := range s.Notifications {
+		labels = append(labels, item.Labels...)
+	}
+
+	return labels
+}
+
+// Plan reads the current state of a Sonarr instance and returns the changes needed to match the desired state.
+// Nothing is changed until Apply() is called on the returned plan.
+func (s *SonarrState) Plan(ctx context.Context, app *sonarr.Sonarr, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := newPlan()
+
+	tags, err := app.GetTagsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	planTags(plan, opts, app, tags, s.allTags())
+
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	(&kind[*sonarr.CustomFormat, *sonarr.CustomFormat]{
+		name:    KindCustomFormat,
+		desired: s.CustomFormats,
+		current: formats,
+		key:     func(d *sonarr.CustomFormat) string { return d.Name },
+		curKey:  func(c *sonarr.CustomFormat) string { return c.Name },
+		curID:   func(c *sonarr.CustomFormat) int64 { return int64(c.ID) },
+		diff: func(d *sonarr.CustomFormat, c *sonarr.CustomFormat) []string {
+			return diffJSON(d, c, "id")
+		},
+		create: func(ctx context.Context, d *sonarr.CustomFormat) (int64, error) {
+			format := *d
+			output, err := app.AddCustomFormatContext(ctx, &format)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return int64(output.ID), nil
+		},
+		update: func(ctx context.Context, d *sonarr.CustomFormat, cur *sonarr.CustomFormat) error {
+			format := *d
+			format.ID = cur.ID
+			_, err := app.UpdateCustomFormatContext(ctx, &format, cur.ID)
+
+			return err //nolint:wrapcheck
+		},
+		remove: func(ctx context.Context, id int64) error {
+			return app.DeleteCustomFormatContext(ctx, int(id)) //nolint:wrapcheck
+		},
+	}).plan(plan, opts)
+
+	profiles, err := app.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	(&kind[*sonarr.QualityProfile, *sonarr.QualityProfile]{
+		name:    KindQualityProfile,
+		desired: s.QualityProfiles,
+		current: profiles,
+		key:     func(d *sonarr.QualityProfile) string { return d.Name },
+		curKey:  func(c *sonarr.QualityProfile) string { return c.Name },
+		curID:   func(c *sonarr.QualityProfile) int64 { return c.ID },
+		diff: func(d *sonarr.QualityProfile, c *sonarr.QualityProfile) []string {
+			profile := *d
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+
+			return diffJSON(&profile, c, "id")
+		},
+		create: func(ctx context.Context, d *sonarr.QualityProfile) (int64, error) {
+			profile := *d
+			profile.ID = 0
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+			output, err := app.AddQualityProfileContext(ctx, &profile)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *sonarr.QualityProfile, cur *sonarr.QualityProfile) error {
+			profile := *d
+			profile.ID = cur.ID
+			profile.FormatItems = plan.formatItems(d.FormatItems)
+			_, err := app.UpdateQualityProfileContext(ctx, &profile)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteQualityProfileContext,
+	}).plan(plan, opts)
+
+	folders, err := app.GetRootFoldersContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	(&kind[string, *sonarr.RootFolder]{
+		name:    KindRootFolder,
+		desired: s.RootFolders,
+		current: folders,
+		key:     func(d string) string { return d },
+		curKey:  func(c *sonarr.RootFolder) string { return c.Path },
+		curID:   func(c *sonarr.RootFolder) int64 { return c.ID },
+		diff:    func(string, *sonarr.RootFolder) []string { return nil },
+		create: func(ctx context.Context, d string) (int64, error) {
+			output, err := app.AddRootFolderContext(ctx, &sonarr.RootFolder{Path: d})
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		remove: app.DeleteRootFolderContext,
+	}).plan(plan, opts)
+
+	delays, err := app.GetDelayProfilesContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	(&kind[*Tagged[sonarr.DelayProfile], *sonarr.DelayProfile]{
+		name:    KindDelayProfile,
+		desired: s.DelayProfiles,
+		current: delays,
+		key:     func(d *Tagged[sonarr.DelayProfile]) string { return delayKey(plan, d.Labels, d.Item.Tags) },
+		curKey:  func(c *sonarr.DelayProfile) string { return delayKey(plan, nil, c.Tags) },
+		curID:   func(c *sonarr.DelayProfile) int64 { return c.ID },
+		diff: func(d *Tagged[sonarr.DelayProfile], c *sonarr.DelayProfile) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[sonarr.DelayProfile]) (int64, error) {
+			profile := d.Item
+			profile.ID = 0
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDelayProfileContext(ctx, &profile)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[sonarr.DelayProfile], cur *sonarr.DelayProfile) error {
+			profile := d.Item
+			profile.ID = cur.ID
+			profile.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDelayProfileContext(ctx, &profile)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDelayProfileContext,
+	}).plan(plan, opts)
+
+	if err := s.planProviders(ctx, plan, opts, app); err != nil {
+		return nil, err
+	}
+
+	return plan.finish(), nil
+}
+
+// planProviders adds the download client, indexer and notification changes to a plan.
+func (s *SonarrState) planProviders(ctx context.Context, plan *Plan, opts *Options, app *sonarr.Sonarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	(&kind[*Tagged[sonarr.DownloadClientInput], *sonarr.DownloadClientOutput]{
+		name:    KindDownloadClient,
+		desired: s.DownloadClients,
+		current: clients,
+		key:     func(d *Tagged[sonarr.DownloadClientInput]) string { return d.Item.Name },
+		curKey:  func(c *sonarr.DownloadClientOutput) string { return c.Name },
+		curID:   func(c *sonarr.DownloadClientOutput) int64 { return c.ID },
+		diff: func(d *Tagged[sonarr.DownloadClientInput], c *sonarr.DownloadClientOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[sonarr.DownloadClientInput]) (int64, error) {
+			client := d.Item
+			client.ID = 0
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddDownloadClientContext(ctx, &client)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[sonarr.DownloadClientInput], cur *sonarr.DownloadClientOutput) error {
+			client := d.Item
+			client.ID = cur.ID
+			client.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateDownloadClientContext(ctx, &client)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteDownloadClientContext,
+	}).plan(plan, opts)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	(&kind[*Tagged[sonarr.IndexerInput], *sonarr.IndexerOutput]{
+		name:    KindIndexer,
+		desired: s.Indexers,
+		current: indexers,
+		key:     func(d *Tagged[sonarr.IndexerInput]) string { return d.Item.Name },
+		curKey:  func(c *sonarr.IndexerOutput) string { return c.Name },
+		curID:   func(c *sonarr.IndexerOutput) int64 { return c.ID },
+		diff: func(d *Tagged[sonarr.IndexerInput], c *sonarr.IndexerOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[sonarr.IndexerInput]) (int64, error) {
+			indexer := d.Item
+			indexer.ID = 0
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddIndexerContext(ctx, &indexer)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[sonarr.IndexerInput], cur *sonarr.IndexerOutput) error {
+			indexer := d.Item
+			indexer.ID = cur.ID
+			indexer.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateIndexerContext(ctx, &indexer)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteIndexerContext,
+	}).plan(plan, opts)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	(&kind[*Tagged[sonarr.NotificationInput], *sonarr.NotificationOutput]{
+		name:    KindNotification,
+		desired: s.Notifications,
+		current: notifications,
+		key:     func(d *Tagged[sonarr.NotificationInput]) string { return d.Item.Name },
+		curKey:  func(c *sonarr.NotificationOutput) string { return c.Name },
+		curID:   func(c *sonarr.NotificationOutput) int64 { return c.ID },
+		diff: func(d *Tagged[sonarr.NotificationInput], c *sonarr.NotificationOutput) []string {
+			return diffTagged(plan, d, c, c.Tags)
+		},
+		create: func(ctx context.Context, d *Tagged[sonarr.NotificationInput]) (int64, error) {
+			notification := d.Item
+			notification.ID = 0
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, nil)
+			output, err := app.AddNotificationContext(ctx, &notification)
+			if err != nil {
+				return 0, err //nolint:wrapcheck
+			}
+
+			return output.ID, nil
+		},
+		update: func(ctx context.Context, d *Tagged[sonarr.NotificationInput], cur *sonarr.NotificationOutput) error {
+			notification := d.Item
+			notification.ID = cur.ID
+			notification.Tags = plan.tagIDs(d.Labels, d.Item.Tags, cur.Tags)
+			_, err := app.UpdateNotificationContext(ctx, &notification)
+
+			return err //nolint:wrapcheck
+		},
+		remove: app.DeleteNotificationContext,
+	}).plan(plan, opts)
+
+	return nil
+}
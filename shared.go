@@ -256,3 +256,55 @@ const (
 func (a ApplyTags) Ptr() *ApplyTags {
 	return &a
 }
+
+// ValidationFailure is returned by a Starr app when a provider (indexer, download client, etc.) fails a test.
+// IsWarning is true when the Severity is "warning"; warnings do not prevent a provider from being saved.
+type ValidationFailure struct {
+	IsWarning           bool        `json:"isWarning"`
+	PropertyName        string      `json:"propertyName"`
+	ErrorMessage        string      `json:"errorMessage"`
+	Severity            string      `json:"severity"`
+	InfoLink            string      `json:"infoLink,omitempty"`
+	DetailedDescription string      `json:"detailedDescription,omitempty"`
+	AttemptedValue      interface{} `json:"attemptedValue,omitempty"`
+}
+
+// ProviderTestResult is returned for each provider tested by a testall endpoint.
+type ProviderTestResult struct {
+	ID                 int64                `json:"id"`
+	IsValid            bool                 `json:"isValid"`
+	ValidationFailures []*ValidationFailure `json:"validationFailures"`
+}
+
+// BulkEditProviders is the input for the provider bulk editors: indexers, download clients and import lists.
+// Only IDs is required. Leave the other members nil to keep their current value.
+// Tags are always sent; with ApplyTags set to TagsReplace, an empty (not nil) list removes every tag.
+// The app ignores members that do not belong to the provider type being edited.
+// You may use starr.True(), starr.False(), starr.Int64(), and starr.String() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for apply tags.
+type BulkEditProviders struct {
+	IDs       []int64    `json:"ids"`
+	Tags      []int      `json:"tags"`
+	ApplyTags *ApplyTags `json:"applyTags,omitempty"`
+	Priority  *int64     `json:"priority,omitempty"` // indexers and download clients.
+	// Enable is used by download clients, and Prowlarr indexers.
+	Enable *bool `json:"enable,omitempty"`
+	// These are used by indexers.
+	EnableRss               *bool `json:"enableRss,omitempty"`
+	EnableAutomaticSearch   *bool `json:"enableAutomaticSearch,omitempty"`
+	EnableInteractiveSearch *bool `json:"enableInteractiveSearch,omitempty"`
+	// These are used by download clients.
+	RemoveCompletedDownloads *bool `json:"removeCompletedDownloads,omitempty"`
+	RemoveFailedDownloads    *bool `json:"removeFailedDownloads,omitempty"`
+	// These are used by import lists. Radarr uses Enabled and EnableAuto, the others use EnableAutomaticAdd.
+	Enabled            *bool   `json:"enabled,omitempty"`
+	EnableAuto         *bool   `json:"enableAuto,omitempty"`
+	EnableAutomaticAdd *bool   `json:"enableAutomaticAdd,omitempty"`
+	RootFolderPath     *string `json:"rootFolderPath,omitempty"`
+	QualityProfileID   *int64  `json:"qualityProfileId,omitempty"`
+}
+
+// BulkDeleteProviders is the input for the provider bulk deleters: indexers, download clients and import lists.
+type BulkDeleteProviders struct {
+	IDs []int64 `json:"ids"`
+}
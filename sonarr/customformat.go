@@ -42,6 +42,9 @@ type CustomFormatField struct {
 	Value    interface{} `json:"value"` // should be a string, but sometimes it's a number.
 	Type     string      `json:"type"`
 	Advanced bool        `json:"advanced"`
+	// HelpText and SelectOptions are only returned by GetCustomFormatSchema.
+	HelpText      string                `json:"helpText,omitempty"`
+	SelectOptions []*starr.SelectOption `json:"selectOptions,omitempty"`
 }
 
 // GetCustomFormats returns all configured Custom Formats.
@@ -53,6 +56,10 @@ func (s *Sonarr) GetCustomFormats() ([]*CustomFormat, error) {
 // GetCustomFormatsContext returns all configured Custom Formats.
 // This data and these endpoints do not exist in Sonarr v3; this is v4 only.
 func (s *Sonarr) GetCustomFormatsContext(ctx context.Context) ([]*CustomFormat, error) {
+	if err := s.v4Only(bpCustomFormat); err != nil {
+		return nil, err
+	}
+
 	var output []*CustomFormat
 
 	req := starr.Request{URI: bpCustomFormat}
@@ -72,6 +79,10 @@ func (s *Sonarr) AddCustomFormat(format *CustomFormat) (*CustomFormat, error) {
 // AddCustomFormatContext creates a new custom format and returns the response (with ID).
 // This data and these endpoints do not exist in Sonarr v3; this is v4 only.
 func (s *Sonarr) AddCustomFormatContext(ctx context.Context, format *CustomFormat) (*CustomFormat, error) {
+	if err := s.v4Only(bpCustomFormat); err != nil {
+		return nil, err
+	}
+
 	var output CustomFormat
 
 	if format == nil {
@@ -102,6 +113,10 @@ func (s *Sonarr) UpdateCustomFormat(cf *CustomFormat, cfID int) (*CustomFormat,
 // UpdateCustomFormatContext updates an existing custom format and returns the response.
 // This data and these endpoints do not exist in Sonarr v3; this is v4 only.
 func (s *Sonarr) UpdateCustomFormatContext(ctx context.Context, format *CustomFormat, cfID int) (*CustomFormat, error) {
+	if err := s.v4Only(bpCustomFormat); err != nil {
+		return nil, err
+	}
+
 	if cfID == 0 {
 		cfID = format.ID
 	}
@@ -130,6 +145,10 @@ func (s *Sonarr) DeleteCustomFormat(cfID int) error {
 // DeleteCustomFormatContext deletes a custom format.
 // This data and these endpoints do not exist in Sonarr v3; this is v4 only.
 func (s *Sonarr) DeleteCustomFormatContext(ctx context.Context, cfID int) error {
+	if err := s.v4Only(bpCustomFormat); err != nil {
+		return err
+	}
+
 	req := starr.Request{URI: path.Join(bpCustomFormat, fmt.Sprint(cfID))}
 	if err := s.DeleteAny(ctx, req); err != nil {
 		return fmt.Errorf("api.Delete(%s): %w", &req, err)
@@ -137,3 +156,28 @@ func (s *Sonarr) DeleteCustomFormatContext(ctx context.Context, cfID int) error
 
 	return nil
 }
+
+// GetCustomFormatSchema returns every custom format specification type, with the fields each type accepts.
+// Use this to build the Specifications for a new custom format.
+// This data and these endpoints do not exist in Sonarr v3; this is v4 only.
+func (s *Sonarr) GetCustomFormatSchema() ([]*CustomFormatSpec, error) {
+	return s.GetCustomFormatSchemaContext(context.Background())
+}
+
+// GetCustomFormatSchemaContext returns every custom format specification type, with the fields each type accepts.
+// Use this to build the Specifications for a new custom format.
+// This data and these endpoints do not exist in Sonarr v3; this is v4 only.
+func (s *Sonarr) GetCustomFormatSchemaContext(ctx context.Context) ([]*CustomFormatSpec, error) {
+	if err := s.v4Only(bpCustomFormat); err != nil {
+		return nil, err
+	}
+
+	var output []*CustomFormatSpec
+
+	req := starr.Request{URI: path.Join(bpCustomFormat, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
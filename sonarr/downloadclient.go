@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -141,3 +142,96 @@ func (s *Sonarr) DeleteDownloadClientContext(ctx context.Context, downloadclient
 
 	return nil
 }
+
+// TestDownloadClient tests a download client's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestDownloadClient(downloadclient *DownloadClientInput) ([]*starr.ValidationFailure, error) {
+	return s.TestDownloadClientContext(context.Background(), downloadclient)
+}
+
+// TestDownloadClientContext tests a download client's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestDownloadClientContext(ctx context.Context, downloadclient *DownloadClientInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(downloadclient); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "test"), Body: &body}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllDownloadClients tests all configured download clients and returns the result for each.
+func (s *Sonarr) TestAllDownloadClients() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllDownloadClientsContext(context.Background())
+}
+
+// TestAllDownloadClientsContext tests all configured download clients and returns the result for each.
+func (s *Sonarr) TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "testall")}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditDownloadClients updates many download clients at once using the bulk editor.
+func (s *Sonarr) EditDownloadClients(edit *starr.BulkEditProviders) ([]*DownloadClientOutput, error) {
+	return s.EditDownloadClientsContext(context.Background(), edit)
+}
+
+// EditDownloadClientsContext updates many download clients at once using the bulk editor.
+func (s *Sonarr) EditDownloadClientsContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*DownloadClientOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteDownloadClients removes many download clients at once using the bulk editor.
+func (s *Sonarr) DeleteDownloadClients(ids []int64) error {
+	return s.DeleteDownloadClientsContext(context.Background(), ids)
+}
+
+// DeleteDownloadClientsContext removes many download clients at once using the bulk editor.
+func (s *Sonarr) DeleteDownloadClientsContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -21,6 +21,15 @@ type DownloadClientConfig struct {
 	DownloadClientWorkingFolders    string `json:"downloadClientWorkingFolders"`
 }
 
+// DownloadClientConfigPatch contains the download client config fields to change with PatchDownloadClientConfig.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type DownloadClientConfigPatch struct {
+	EnableCompletedDownloadHandling *bool   `json:"enableCompletedDownloadHandling,omitempty"`
+	AutoRedownloadFailed            *bool   `json:"autoRedownloadFailed,omitempty"`
+	DownloadClientWorkingFolders    *string `json:"downloadClientWorkingFolders,omitempty"`
+}
+
 // GetDownloadClientConfig returns the download client config.
 func (s *Sonarr) GetDownloadClientConfig() (*DownloadClientConfig, error) {
 	return s.GetDownloadClientConfigContext(context.Background())
@@ -61,3 +70,21 @@ func (s *Sonarr) UpdateDownloadClientConfigContext(ctx context.Context,
 
 	return &output, nil
 }
+
+// PatchDownloadClientConfig changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchDownloadClientConfig(patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	return s.PatchDownloadClientConfigContext(context.Background(), patch)
+}
+
+// PatchDownloadClientConfigContext changes only the non-nil fields in patch, and leaves the rest of the download client config alone.
+// This fetches the current download client config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchDownloadClientConfigContext(ctx context.Context, patch *DownloadClientConfigPatch) (*DownloadClientConfig, error) {
+	var output DownloadClientConfig
+
+	if err := starr.PatchConfig(ctx, s, bpDownloadClientConfig, true, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
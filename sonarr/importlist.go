@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -142,3 +143,96 @@ func (s *Sonarr) DeleteImportListContext(ctx context.Context, importListID int64
 
 	return nil
 }
+
+// TestImportList tests an import list's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestImportList(list *ImportListInput) ([]*starr.ValidationFailure, error) {
+	return s.TestImportListContext(context.Background(), list)
+}
+
+// TestImportListContext tests an import list's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestImportListContext(ctx context.Context, list *ImportListInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "test"), Body: &body}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllImportLists tests all configured import lists and returns the result for each.
+func (s *Sonarr) TestAllImportLists() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests all configured import lists and returns the result for each.
+func (s *Sonarr) TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditImportLists updates many import lists at once using the bulk editor.
+func (s *Sonarr) EditImportLists(edit *starr.BulkEditProviders) ([]*ImportListOutput, error) {
+	return s.EditImportListsContext(context.Background(), edit)
+}
+
+// EditImportListsContext updates many import lists at once using the bulk editor.
+func (s *Sonarr) EditImportListsContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*ImportListOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteImportLists removes many import lists at once using the bulk editor.
+func (s *Sonarr) DeleteImportLists(ids []int64) error {
+	return s.DeleteImportListsContext(context.Background(), ids)
+}
+
+// DeleteImportListsContext removes many import lists at once using the bulk editor.
+func (s *Sonarr) DeleteImportListsContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
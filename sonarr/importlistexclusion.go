@@ -0,0 +1,180 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpImportListExclusion = APIver + "/importlistexclusion"
+
+// ImportListExclusion is a Sonarr excluded series. Import lists never add these.
+type ImportListExclusion struct {
+	ID     int64  `json:"id,omitempty"`
+	TvdbID int64  `json:"tvdbId"`
+	Title  string `json:"title"`
+}
+
+// ImportListExclusionPage is a single page of import list exclusions.
+type ImportListExclusionPage struct {
+	Page          int                    `json:"page"`
+	PageSize      int                    `json:"pageSize"`
+	SortKey       string                 `json:"sortKey"`
+	SortDirection string                 `json:"sortDirection"`
+	TotalRecords  int                    `json:"totalRecords"`
+	Records       []*ImportListExclusion `json:"records"`
+}
+
+// GetImportListExclusions returns all import list exclusions.
+func (s *Sonarr) GetImportListExclusions() ([]*ImportListExclusion, error) {
+	return s.GetImportListExclusionsContext(context.Background())
+}
+
+// GetImportListExclusionsContext returns all import list exclusions.
+func (s *Sonarr) GetImportListExclusionsContext(ctx context.Context) ([]*ImportListExclusion, error) {
+	var output []*ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetImportListExclusionsPage returns a single page of import list exclusions.
+// The page size and number is configurable with the input request parameters. Sorts by id by default.
+func (s *Sonarr) GetImportListExclusionsPage(params *starr.PageReq) (*ImportListExclusionPage, error) {
+	return s.GetImportListExclusionsPageContext(context.Background(), params)
+}
+
+// GetImportListExclusionsPageContext returns a single page of import list exclusions.
+// The page size and number is configurable with the input request parameters. Sorts by id by default.
+func (s *Sonarr) GetImportListExclusionsPageContext(
+	ctx context.Context,
+	params *starr.PageReq,
+) (*ImportListExclusionPage, error) {
+	var output ImportListExclusionPage
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, "paged"), Query: params.Params()}
+	if params.SortKey == "" {
+		req.Query.Set("sortKey", "id") // exclusions have no date.
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListExclusion returns a single import list exclusion.
+func (s *Sonarr) GetImportListExclusion(exclusionID int64) (*ImportListExclusion, error) {
+	return s.GetImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// GetImportListExclusionContext returns a single import list exclusion.
+func (s *Sonarr) GetImportListExclusionContext(ctx context.Context, exclusionID int64) (*ImportListExclusion, error) {
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddImportListExclusion creates an import list exclusion.
+func (s *Sonarr) AddImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return s.AddImportListExclusionContext(context.Background(), exclusion)
+}
+
+// AddImportListExclusionContext creates an import list exclusion.
+func (s *Sonarr) AddImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	input := *exclusion
+	input.ID = 0
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion, Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateImportListExclusion updates an import list exclusion.
+func (s *Sonarr) UpdateImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return s.UpdateImportListExclusionContext(context.Background(), exclusion)
+}
+
+// UpdateImportListExclusionContext updates an import list exclusion.
+func (s *Sonarr) UpdateImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(exclusion); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusion.ID)), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteImportListExclusion removes a single import list exclusion.
+func (s *Sonarr) DeleteImportListExclusion(exclusionID int64) error {
+	return s.DeleteImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// DeleteImportListExclusionContext removes a single import list exclusion.
+func (s *Sonarr) DeleteImportListExclusionContext(ctx context.Context, exclusionID int64) error {
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteImportListExclusions removes many import list exclusions.
+func (s *Sonarr) DeleteImportListExclusions(exclusionIDs []int64) error {
+	return s.DeleteImportListExclusionsContext(context.Background(), exclusionIDs)
+}
+
+// DeleteImportListExclusionsContext removes many import list exclusions.
+func (s *Sonarr) DeleteImportListExclusionsContext(ctx context.Context, exclusionIDs []int64) error {
+	var errs string
+
+	for _, exclusionID := range exclusionIDs {
+		if err := s.DeleteImportListExclusionContext(ctx, exclusionID); err != nil {
+			errs += err.Error() + " "
+		}
+	}
+
+	if errs != "" {
+		return fmt.Errorf("%w: %s", starr.ErrRequestError, errs)
+	}
+
+	return nil
+}
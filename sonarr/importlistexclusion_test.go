@@ -0,0 +1,104 @@
+package sonarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetImportListExclusionsPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"importlistexclusion", "paged?page=2&pageSize=1&sortDirection=ascending&sortKey=id"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 1},
+			ResponseBody: `{"page":2,"pageSize":1,"sortKey":"id","sortDirection":"ascending","totalRecords":2,` +
+				`"records":[{"id":2,"tvdbId":81189,"title":"Breaking Bad"}]}`,
+			WithResponse: &sonarr.ImportListExclusionPage{
+				Page:          2,
+				PageSize:      1,
+				SortKey:       "id",
+				SortDirection: "ascending",
+				TotalRecords:  2,
+				Records:       []*sonarr.ImportListExclusion{{ID: 2, TvdbID: 81189, Title: "Breaking Bad"}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"importlistexclusion", "paged?page=2&pageSize=1&sortDirection=ascending&sortKey=id"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 1},
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   (*sonarr.ImportListExclusionPage)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportListExclusionsPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddImportListExclusion(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "201",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  201,
+			WithRequest:     &sonarr.ImportListExclusion{ID: 5, TvdbID: 81189, Title: "Breaking Bad"},
+			ExpectedRequest: `{"tvdbId":81189,"title":"Breaking Bad"}` + "\n",
+			ResponseBody:    `{"id":3,"tvdbId":81189,"title":"Breaking Bad"}`,
+			WithResponse:    &sonarr.ImportListExclusion{ID: 3, TvdbID: 81189, Title: "Breaking Bad"},
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  400,
+			WithRequest:     &sonarr.ImportListExclusion{TvdbID: 81189, Title: "Breaking Bad"},
+			ExpectedRequest: `{"tvdbId":81189,"title":"Breaking Bad"}` + "\n",
+			ResponseBody:    `{"message": "BadRequest"}`,
+			WithResponse:    (*sonarr.ImportListExclusion)(nil),
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			input := test.WithRequest.(*sonarr.ImportListExclusion)
+			inputID := input.ID
+			output, err := client.AddImportListExclusion(input)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+			assert.Equal(t, inputID, input.ID, "the input exclusion was changed")
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -140,3 +141,96 @@ func (s *Sonarr) DeleteIndexerContext(ctx context.Context, indexerID int64) erro
 
 	return nil
 }
+
+// TestIndexer tests an indexer's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestIndexer(indexer *IndexerInput) ([]*starr.ValidationFailure, error) {
+	return s.TestIndexerContext(context.Background(), indexer)
+}
+
+// TestIndexerContext tests an indexer's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestIndexerContext(ctx context.Context, indexer *IndexerInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpIndexer, "test"), Body: &body}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllIndexers tests all configured indexers and returns the result for each.
+func (s *Sonarr) TestAllIndexers() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests all configured indexers and returns the result for each.
+func (s *Sonarr) TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// EditIndexers updates many indexers at once using the bulk editor.
+func (s *Sonarr) EditIndexers(edit *starr.BulkEditProviders) ([]*IndexerOutput, error) {
+	return s.EditIndexersContext(context.Background(), edit)
+}
+
+// EditIndexersContext updates many indexers at once using the bulk editor.
+func (s *Sonarr) EditIndexersContext(ctx context.Context, edit *starr.BulkEditProviders) ([]*IndexerOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(edit); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteIndexers removes many indexers at once using the bulk editor.
+func (s *Sonarr) DeleteIndexers(ids []int64) error {
+	return s.DeleteIndexersContext(context.Background(), ids)
+}
+
+// DeleteIndexersContext removes many indexers at once using the bulk editor.
+func (s *Sonarr) DeleteIndexersContext(ctx context.Context, ids []int64) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.BulkDeleteProviders{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
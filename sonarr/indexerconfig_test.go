@@ -1,10 +1,14 @@
 package sonarr_test
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golift.io/starr"
 	"golift.io/starr/sonarr"
 	"golift.io/starr/starrtest"
@@ -119,3 +123,29 @@ func TestUpdateIndexerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchIndexerConfig(t *testing.T) {
+	t.Parallel()
+
+	var body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET " + path.Join("/", starr.API, sonarr.APIver, "config", "indexer"):
+			_, _ = w.Write([]byte(`{"id":1,"minimumAge":5,"retention":30,"maximumSize":0,"rssSyncInterval":15}`))
+		case "PUT " + path.Join("/", starr.API, sonarr.APIver, "config", "indexer", "1"):
+			input, _ := io.ReadAll(r.Body)
+			body = string(input)
+			_, _ = w.Write(input)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	output, err := client.PatchIndexerConfig(&sonarr.IndexerConfigPatch{MinimumAge: starr.Int64(0)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"minimumAge":0,"retention":30,"maximumSize":0,"rssSyncInterval":15}`, body)
+	assert.Equal(t, &sonarr.IndexerConfig{ID: 1, Retention: 30, RssSyncInterval: 15}, output)
+}
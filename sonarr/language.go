@@ -0,0 +1,48 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for Language calls.
+const bpLanguage = APIver + "/language"
+
+// GetLanguages returns every language Sonarr knows about.
+// In Sonarr v4, use these to set the Language in a quality profile. v3 uses language profiles instead.
+func (s *Sonarr) GetLanguages() ([]*starr.Value, error) {
+	return s.GetLanguagesContext(context.Background())
+}
+
+// GetLanguagesContext returns every language Sonarr knows about.
+// In Sonarr v4, use these to set the Language in a quality profile. v3 uses language profiles instead.
+func (s *Sonarr) GetLanguagesContext(ctx context.Context) ([]*starr.Value, error) {
+	var output []*starr.Value
+
+	req := starr.Request{URI: bpLanguage}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetLanguage returns a single language by ID.
+func (s *Sonarr) GetLanguage(languageID int64) (*starr.Value, error) {
+	return s.GetLanguageContext(context.Background(), languageID)
+}
+
+// GetLanguageContext returns a single language by ID.
+func (s *Sonarr) GetLanguageContext(ctx context.Context, languageID int64) (*starr.Value, error) {
+	var output starr.Value
+
+	req := starr.Request{URI: path.Join(bpLanguage, fmt.Sprint(languageID))}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,68 @@
+package sonarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetLanguages(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "language"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id":1,"name":"English","nameLower":"english"},{"id":2,"name":"French","nameLower":"french"}]`,
+			WithResponse:   []*starr.Value{{ID: 1, Name: "English"}, {ID: 2, Name: "French"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "language"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*starr.Value(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetLanguages()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestVersionErrors(t *testing.T) {
+	t.Parallel()
+
+	// No requests are made; the version checks happen first.
+	client := sonarr.New(starr.New("mockAPIkey", "http://127.0.0.1:1", 0))
+
+	client.SetAppInfo(&starr.AppInfo{App: starr.Sonarr, Version: "4.0.0.748"})
+	_, err := client.GetLanguageProfiles()
+	require.ErrorIs(t, err, sonarr.ErrV3Only)
+	_, err = client.AddReleaseProfile(&sonarr.ReleaseProfile{Preferred: []*starr.KeyValue{{Key: "x265", Value: 10}}})
+	require.ErrorIs(t, err, sonarr.ErrV3Only)
+
+	client.SetAppInfo(&starr.AppInfo{App: starr.Sonarr, Version: "3.0.10.1567"})
+	_, err = client.GetCustomFormats()
+	require.ErrorIs(t, err, sonarr.ErrV4Only)
+	err = client.DeleteCustomFormat(1)
+	require.ErrorIs(t, err, sonarr.ErrV4Only)
+}
@@ -10,6 +10,8 @@ import (
 	"golift.io/starr"
 )
 
+/* Language Profiles do not exist in Sonarr v4; this is v3 only. v4 uses a language in each quality profile. */
+
 // Define Base Path for Language Profile calls.
 const bpLanguageProfile = APIver + "/languageProfile"
 
@@ -35,6 +37,10 @@ func (s *Sonarr) GetLanguageProfiles() ([]*LanguageProfile, error) {
 
 // GetLanguageProfilesContext returns all configured language profiles.
 func (s *Sonarr) GetLanguageProfilesContext(ctx context.Context) ([]*LanguageProfile, error) {
+	if err := s.v3Only(bpLanguageProfile); err != nil {
+		return nil, err
+	}
+
 	var output []*LanguageProfile
 
 	req := starr.Request{URI: bpLanguageProfile}
@@ -52,6 +58,10 @@ func (s *Sonarr) GetLanguageProfile(profileID int64) (*LanguageProfile, error) {
 
 // GetLanguageProfileContext returns a single language profile.
 func (s *Sonarr) GetLanguageProfileContext(ctx context.Context, profileID int64) (*LanguageProfile, error) {
+	if err := s.v3Only(bpLanguageProfile); err != nil {
+		return nil, err
+	}
+
 	var output LanguageProfile
 
 	req := starr.Request{URI: path.Join(bpLanguageProfile, fmt.Sprint(profileID))}
@@ -69,6 +79,10 @@ func (s *Sonarr) AddLanguageProfile(profile *LanguageProfile) (*LanguageProfile,
 
 // AddLanguageProfileContext creates a language profile.
 func (s *Sonarr) AddLanguageProfileContext(ctx context.Context, profile *LanguageProfile) (*LanguageProfile, error) {
+	if err := s.v3Only(bpLanguageProfile); err != nil {
+		return nil, err
+	}
+
 	var output LanguageProfile
 
 	var body bytes.Buffer
@@ -91,6 +105,10 @@ func (s *Sonarr) UpdateLanguageProfile(profile *LanguageProfile) (*LanguageProfi
 
 // UpdateLanguageProfileContext updates the language profile.
 func (s *Sonarr) UpdateLanguageProfileContext(ctx context.Context, profile *LanguageProfile) (*LanguageProfile, error) {
+	if err := s.v3Only(bpLanguageProfile); err != nil {
+		return nil, err
+	}
+
 	var output LanguageProfile
 
 	var body bytes.Buffer
@@ -113,6 +131,10 @@ func (s *Sonarr) DeleteLanguageProfile(profileID int64) error {
 
 // DeleteLanguageProfileContext removes a single language profile.
 func (s *Sonarr) DeleteLanguageProfileContext(ctx context.Context, profileID int64) error {
+	if err := s.v3Only(bpLanguageProfile); err != nil {
+		return err
+	}
+
 	req := starr.Request{URI: path.Join(bpLanguageProfile, fmt.Sprint(profileID))}
 	if err := s.DeleteAny(ctx, req); err != nil {
 		return fmt.Errorf("api.Delete(%s): %w", &req, err)
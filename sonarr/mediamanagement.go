@@ -35,6 +35,30 @@ type MediaManagement struct {
 	RescanAfterRefresh                        string `json:"rescanAfterRefresh,omitempty"`
 }
 
+// MediaManagementPatch contains the media management config fields to change with PatchMediaManagement.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type MediaManagementPatch struct {
+	AutoUnmonitorPreviouslyDownloadedEpisodes *bool   `json:"autoUnmonitorPreviouslyDownloadedEpisodes,omitempty"`
+	CopyUsingHardlinks                        *bool   `json:"copyUsingHardlinks,omitempty"`
+	CreateEmptySeriesFolders                  *bool   `json:"createEmptySeriesFolders,omitempty"`
+	DeleteEmptyFolders                        *bool   `json:"deleteEmptyFolders,omitempty"`
+	EnableMediaInfo                           *bool   `json:"enableMediaInfo,omitempty"`
+	ImportExtraFiles                          *bool   `json:"importExtraFiles,omitempty"`
+	SetPermissionsLinux                       *bool   `json:"setPermissionsLinux,omitempty"`
+	SkipFreeSpaceCheckWhenImporting           *bool   `json:"skipFreeSpaceCheckWhenImporting,omitempty"`
+	MinimumFreeSpaceWhenImporting             *int64  `json:"minimumFreeSpaceWhenImporting,omitempty"`
+	RecycleBinCleanupDays                     *int64  `json:"recycleBinCleanupDays,omitempty"`
+	ChmodFolder                               *string `json:"chmodFolder,omitempty"`
+	ChownGroup                                *string `json:"chownGroup,omitempty"`
+	DownloadPropersAndRepacks                 *string `json:"downloadPropersAndRepacks,omitempty"`
+	EpisodeTitleRequired                      *string `json:"episodeTitleRequired,omitempty"`
+	ExtraFileExtensions                       *string `json:"extraFileExtensions,omitempty"`
+	FileDate                                  *string `json:"fileDate,omitempty"`
+	RecycleBin                                *string `json:"recycleBin,omitempty"`
+	RescanAfterRefresh                        *string `json:"rescanAfterRefresh,omitempty"`
+}
+
 // GetMediaManagement returns the mediaManagement.
 func (s *Sonarr) GetMediaManagement() (*MediaManagement, error) {
 	return s.GetMediaManagementContext(context.Background())
@@ -73,3 +97,21 @@ func (s *Sonarr) UpdateMediaManagementContext(ctx context.Context, mMgt *MediaMa
 
 	return &output, nil
 }
+
+// PatchMediaManagement changes only the non-nil fields in patch, and leaves the rest of the media management config alone.
+// This fetches the current media management config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchMediaManagement(patch *MediaManagementPatch) (*MediaManagement, error) {
+	return s.PatchMediaManagementContext(context.Background(), patch)
+}
+
+// PatchMediaManagementContext changes only the non-nil fields in patch, and leaves the rest of the media management config alone.
+// This fetches the current media management config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchMediaManagementContext(ctx context.Context, patch *MediaManagementPatch) (*MediaManagement, error) {
+	var output MediaManagement
+
+	if err := starr.PatchConfig(ctx, s, bpMediaManagement, false, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
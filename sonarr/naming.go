@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
@@ -32,6 +33,27 @@ type Naming struct {
 	StandardEpisodeFormat    string `json:"standardEpisodeFormat,omitempty"`
 }
 
+// NamingPatch contains the naming config fields to change with PatchNaming.
+// Only non-nil fields are sent, so they may be set to false, 0 or an empty string.
+// Use starr.True(), starr.False(), starr.Int64() and starr.String() to fill it in.
+type NamingPatch struct {
+	RenameEpisodes           *bool   `json:"renameEpisodes,omitempty"`
+	ReplaceIllegalCharacters *bool   `json:"replaceIllegalCharacters,omitempty"`
+	IncludeQuality           *bool   `json:"includeQuality,omitempty"`
+	IncludeSeriesTitle       *bool   `json:"includeSeriesTitle,omitempty"`
+	IncludeEpisodeTitle      *bool   `json:"includeEpisodeTitle,omitempty"`
+	ReplaceSpaces            *bool   `json:"replaceSpaces,omitempty"`
+	MultiEpisodeStyle        *int64  `json:"multiEpisodeStyle,omitempty"`
+	Separator                *string `json:"separator,omitempty"`
+	NumberStyle              *string `json:"numberStyle,omitempty"`
+	DailyEpisodeFormat       *string `json:"dailyEpisodeFormat,omitempty"`
+	AnimeEpisodeFormat       *string `json:"animeEpisodeFormat,omitempty"`
+	SeriesFolderFormat       *string `json:"seriesFolderFormat,omitempty"`
+	SeasonFolderFormat       *string `json:"seasonFolderFormat,omitempty"`
+	SpecialsFolderFormat     *string `json:"specialsFolderFormat,omitempty"`
+	StandardEpisodeFormat    *string `json:"standardEpisodeFormat,omitempty"`
+}
+
 // GetNaming returns the naming.
 func (s *Sonarr) GetNaming() (*Naming, error) {
 	return s.GetNamingContext(context.Background())
@@ -70,3 +92,142 @@ func (s *Sonarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nami
 
 	return &output, nil
 }
+
+// PatchNaming changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchNaming(patch *NamingPatch) (*Naming, error) {
+	return s.PatchNamingContext(context.Background(), patch)
+}
+
+// PatchNamingContext changes only the non-nil fields in patch, and leaves the rest of the naming config alone.
+// This fetches the current naming config, merges the patch into it, and updates it.
+func (s *Sonarr) PatchNamingContext(ctx context.Context, patch *NamingPatch) (*Naming, error) {
+	var output Naming
+
+	if err := starr.PatchConfig(ctx, s, bpNaming, false, patch, &output); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &output, nil
+}
+
+// NamingExamples contains the sample file and folder names rendered from a naming config.
+type NamingExamples struct {
+	SingleEpisodeExample     string `json:"singleEpisodeExample"`
+	MultiEpisodeExample      string `json:"multiEpisodeExample"`
+	DailyEpisodeExample      string `json:"dailyEpisodeExample"`
+	AnimeEpisodeExample      string `json:"animeEpisodeExample"`
+	AnimeMultiEpisodeExample string `json:"animeMultiEpisodeExample"`
+	SeriesFolderExample      string `json:"seriesFolderExample"`
+	SeasonFolderExample      string `json:"seasonFolderExample"`
+	SpecialsFolderExample    string `json:"specialsFolderExample"`
+}
+
+// namingTokens are the tokens Sonarr accepts in naming formats. Some take a :format, like {Release Year:0000}.
+//
+//nolint:gochecknoglobals
+var namingTokens = []string{
+	"Series Title",
+	"Series TitleYear",
+	"Series TitleWithoutYear",
+	"Series CleanTitle",
+	"Series CleanTitleYear",
+	"Series CleanTitleWithoutYear",
+	"Series TitleThe",
+	"Series CleanTitleThe",
+	"Series TitleTheYear",
+	"Series CleanTitleTheYear",
+	"Series TitleTheWithoutYear",
+	"Series CleanTitleTheWithoutYear",
+	"Series TitleFirstCharacter",
+	"Series Year",
+	"Release Year",
+	"ImdbId",
+	"TvdbId",
+	"TvMazeId",
+	"TmdbId",
+	"season",
+	"episode",
+	"absolute",
+	"Air-Date",
+	"Air Date",
+	"Episode Title",
+	"Episode CleanTitle",
+	"Episode TitleFirst",
+	"Quality Proper",
+	"Quality Real",
+	"MediaInfo Simple",
+	"MediaInfo Full",
+	"MediaInfo Video",
+	"MediaInfo VideoCodec",
+	"MediaInfo VideoBitDepth",
+	"MediaInfo VideoDynamicRange",
+	"MediaInfo VideoDynamicRangeType",
+	"MediaInfo Audio",
+	"MediaInfo AudioCodec",
+	"MediaInfo AudioChannels",
+	"MediaInfo AudioLanguages",
+	"MediaInfo AudioLanguagesAll",
+	"MediaInfo SubtitleLanguages",
+	"MediaInfo SubtitleLanguagesAll",
+	"MediaInfo 3D",
+	"Release Hash",
+	"Preferred Words",
+	"Quality Full",
+	"Quality Title",
+	"Release Group",
+	"Custom Formats",
+	"Custom Format",
+	"Custom Format Score",
+	"Original Title",
+	"Original Filename",
+}
+
+// GetNamingExamples renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (s *Sonarr) GetNamingExamples(naming *Naming) (*NamingExamples, error) {
+	return s.GetNamingExamplesContext(context.Background(), naming)
+}
+
+// GetNamingExamplesContext renders sample file and folder names from a proposed naming config.
+// The config is not saved. Use this to preview a format before calling UpdateNaming.
+func (s *Sonarr) GetNamingExamplesContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	query, err := starr.NamingQuery(naming)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped.
+	}
+
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: query}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// ValidateNamingFormat returns starr.ErrUnknownToken if a naming format contains a token Sonarr does not support.
+// This check is local; it does not contact Sonarr. An input like "{Series Title} - S{season:00}E{episode:00}" is valid.
+func ValidateNamingFormat(format string) error {
+	return starr.ValidateNamingFormat(format, namingTokens) //nolint:wrapcheck // the error is already wrapped.
+}
+
+// Validate checks every format in the naming config for unknown tokens. Empty formats are skipped.
+// Returns an error wrapping starr.ErrUnknownToken that names the first invalid format.
+func (n *Naming) Validate() error {
+	for _, format := range []struct{ name, format string }{
+		{"StandardEpisodeFormat", n.StandardEpisodeFormat},
+		{"DailyEpisodeFormat", n.DailyEpisodeFormat},
+		{"AnimeEpisodeFormat", n.AnimeEpisodeFormat},
+		{"SeriesFolderFormat", n.SeriesFolderFormat},
+		{"SeasonFolderFormat", n.SeasonFolderFormat},
+		{"SpecialsFolderFormat", n.SpecialsFolderFormat},
+	} {
+		if err := ValidateNamingFormat(format.format); err != nil {
+			return fmt.Errorf("%s: %w", format.name, err)
+		}
+	}
+
+	return nil
+}
@@ -144,3 +144,84 @@ func TestUpdateNaming(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNamingExamples(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "config", "naming", "examples") +
+				"?renameEpisodes=true&seasonFolderFormat=Season+%7Bseason%7D",
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    &sonarr.Naming{RenameEpisodes: true, SeasonFolderFormat: "Season {season}"},
+			ResponseBody:   `{"singleEpisodeExample":"The Series - S01E01","seasonFolderExample":"Season 1"}`,
+			WithResponse: &sonarr.NamingExamples{
+				SingleEpisodeExample: "The Series - S01E01",
+				SeasonFolderExample:  "Season 1",
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "config", "naming", "examples"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    &sonarr.Naming{},
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   (*sonarr.NamingExamples)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetNamingExamples(test.WithRequest.(*sonarr.Naming))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestNamingValidate(t *testing.T) {
+	t.Parallel()
+
+	naming := &sonarr.Naming{
+		StandardEpisodeFormat: "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		SeasonFolderFormat:    "Season {season}",
+	}
+	assert.NoError(t, naming.Validate())
+
+	naming.SeriesFolderFormat = "{Series Name}"
+	assert.ErrorIs(t, naming.Validate(), starr.ErrUnknownToken)
+	assert.ErrorIs(t, sonarr.ValidateNamingFormat("{Air-Date} {Air.Date} {Episode Titel}"), starr.ErrUnknownToken)
+}
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{
+		// The Sonarr defaults.
+		"{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"{Series Title} - {Air-Date} - {Episode Title} {Quality Full}",
+		"{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"{Series Title}",
+		"Season {season}",
+		"Specials",
+		// A few other real formats.
+		`{Series TitleYear} - S{season:00}E{episode:00} - {Episode CleanTitle} [{Custom Formats }` +
+			`{Quality Full}]{[MediaInfo VideoDynamicRangeType]}{[Mediainfo AudioCodec}` +
+			`{ Mediainfo AudioChannels]}{[MediaInfo VideoCodec]}{-Release Group}`,
+		`{Series TitleYear} - S{season:00}E{episode:00} - {absolute:000} - {Episode TitleFirst:30} {[MediaInfo 3D]}`,
+		"{Series Title} ({Release Year}) [tvdbid-{TvdbId}]",
+	} {
+		assert.NoError(t, sonarr.ValidateNamingFormat(format), format)
+	}
+
+	assert.ErrorIs(t, sonarr.ValidateNamingFormat("{Release Year} {Release Yaer}"), starr.ErrUnknownToken)
+}
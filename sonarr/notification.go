@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path"
 
@@ -157,3 +158,54 @@ func (s *Sonarr) DeleteNotificationContext(ctx context.Context, notificationID i
 
 	return nil
 }
+
+// TestNotification tests a notification's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestNotification(notification *NotificationInput) ([]*starr.ValidationFailure, error) {
+	return s.TestNotificationContext(context.Background(), notification)
+}
+
+// TestNotificationContext tests a notification's settings without saving it.
+// Validation failures reported by Sonarr are returned as a list, not as an error.
+func (s *Sonarr) TestNotificationContext(ctx context.Context, notification *NotificationInput) ([]*starr.ValidationFailure, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(notification); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpNotification, err)
+	}
+
+	var (
+		output interface{}
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpNotification, "test"), Body: &body}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Failures, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil, nil //nolint:nilnil // no failures is not an error.
+}
+
+// TestAllNotifications tests all configured notifications and returns the result for each.
+func (s *Sonarr) TestAllNotifications() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllNotificationsContext(context.Background())
+}
+
+// TestAllNotificationsContext tests all configured notifications and returns the result for each.
+func (s *Sonarr) TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var (
+		output []*starr.ProviderTestResult
+		valErr *starr.ValidationError
+	)
+
+	req := starr.Request{URI: path.Join(bpNotification, "testall")}
+	if err := s.PostInto(ctx, req, &output); errors.As(err, &valErr) {
+		return valErr.Results, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
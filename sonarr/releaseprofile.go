@@ -66,7 +66,12 @@ func (s *Sonarr) AddReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, er
 }
 
 // AddReleaseProfileContext creates a release profile.
+// Returns ErrV3Only if the profile has preferred words and Detect found Sonarr v4.
 func (s *Sonarr) AddReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error) {
+	if err := s.checkReleaseProfile(profile); err != nil {
+		return nil, err
+	}
+
 	var output ReleaseProfile
 
 	var body bytes.Buffer
@@ -88,7 +93,12 @@ func (s *Sonarr) UpdateReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile,
 }
 
 // UpdateReleaseProfileContext updates the release profile.
+// Returns ErrV3Only if the profile has preferred words and Detect found Sonarr v4.
 func (s *Sonarr) UpdateReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error) {
+	if err := s.checkReleaseProfile(profile); err != nil {
+		return nil, err
+	}
+
 	var output ReleaseProfile
 
 	var body bytes.Buffer
@@ -104,6 +114,16 @@ func (s *Sonarr) UpdateReleaseProfileContext(ctx context.Context, profile *Relea
 	return &output, nil
 }
 
+// checkReleaseProfile returns ErrV3Only if the profile uses preferred words with Sonarr v4.
+// Sonarr v4 silently drops these fields; use custom formats instead.
+func (s *Sonarr) checkReleaseProfile(profile *ReleaseProfile) error {
+	if profile == nil || (len(profile.Preferred) == 0 && profile.IncPrefOnRename == nil) {
+		return nil
+	}
+
+	return s.v3Only("release profile preferred words")
+}
+
 // DeleteReleaseProfile removes a single release profile.
 func (s *Sonarr) DeleteReleaseProfile(profileID int64) error {
 	return s.DeleteReleaseProfileContext(context.Background(), profileID)
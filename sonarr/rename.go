@@ -0,0 +1,68 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is a file that does not match the naming config, from the /api/v3/rename endpoint.
+type Rename struct {
+	SeriesID       int64   `json:"seriesId"`
+	SeasonNumber   int64   `json:"seasonNumber"`
+	EpisodeNumbers []int64 `json:"episodeNumbers"`
+	EpisodeFileID  int64   `json:"episodeFileId"`
+	ExistingPath   string  `json:"existingPath"`
+	NewPath        string  `json:"newPath"`
+}
+
+// GetRename returns the existing and new path for each series file that does not match the naming config.
+// Pass a negative seasonNumber to return files from every season.
+// Use this to preview the changes RenameFiles makes.
+func (s *Sonarr) GetRename(seriesID, seasonNumber int64) ([]*Rename, error) {
+	return s.GetRenameContext(context.Background(), seriesID, seasonNumber)
+}
+
+// GetRenameContext returns the existing and new path for each series file that does not match the naming config.
+// Pass a negative seasonNumber to return files from every season.
+// Use this to preview the changes RenameFiles makes.
+func (s *Sonarr) GetRenameContext(ctx context.Context, seriesID, seasonNumber int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Add("seriesId", fmt.Sprint(seriesID))
+
+	if seasonNumber >= 0 {
+		req.Query.Add("seasonNumber", fmt.Sprint(seasonNumber))
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RenameFiles renames files that belong to a series, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+func (s *Sonarr) RenameFiles(seriesID int64, fileIDs []int64) (*CommandResponse, error) {
+	return s.RenameFilesContext(context.Background(), seriesID, fileIDs)
+}
+
+// RenameFilesContext renames files that belong to a series, using the naming config.
+// Get the file IDs from GetRename. This sends the RenameFiles command, and waits for it to finish.
+// Returns an error wrapping starr.ErrCommandFailed if the command does not complete.
+// Cancel the context to stop waiting; the command keeps running in Sonarr.
+func (s *Sonarr) RenameFilesContext(ctx context.Context, seriesID int64, fileIDs []int64) (*CommandResponse, error) {
+	cmd, err := s.SendCommandContext(ctx, &CommandRequest{Name: "RenameFiles", SeriesID: seriesID, Files: fileIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.WaitForCommandContext(ctx, cmd, starr.DefaultCommandInterval)
+}
@@ -151,6 +151,8 @@ func (s *Sonarr) UpdateSeries(series *AddSeriesInput, moveFiles bool) (*Series,
 
 // UpdateSeriesContext updates a series in place.
 func (s *Sonarr) UpdateSeriesContext(ctx context.Context, series *AddSeriesInput, moveFiles bool) (*Series, error) {
+	series = s.seriesInput(series)
+
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(series); err != nil {
 		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSeries, err)
@@ -179,6 +181,8 @@ func (s *Sonarr) AddSeries(series *AddSeriesInput) (*Series, error) {
 
 // AddSeriesContext adds a new series to Sonarr.
 func (s *Sonarr) AddSeriesContext(ctx context.Context, series *AddSeriesInput) (*Series, error) {
+	series = s.seriesInput(series)
+
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(series); err != nil {
 		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSeries, err)
@@ -194,6 +198,19 @@ func (s *Sonarr) AddSeriesContext(ctx context.Context, series *AddSeriesInput) (
 	return &output, nil
 }
 
+// seriesInput removes the language profile from the input if Sonarr is v4, because v4 has no language profiles.
+// The input is copied, so the caller's data is not changed.
+func (s *Sonarr) seriesInput(series *AddSeriesInput) *AddSeriesInput {
+	if !s.IsV4() || series == nil || series.LanguageProfileID == 0 {
+		return series
+	}
+
+	input := *series
+	input.LanguageProfileID = 0
+
+	return &input
+}
+
 // GetSeriesByID locates and returns a series by DB [series] ID.
 func (s *Sonarr) GetSeriesByID(seriesID int64) (*Series, error) {
 	return s.GetSeriesByIDContext(context.Background(), seriesID)
@@ -1,11 +1,16 @@
 package sonarr_test
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golift.io/starr"
 	"golift.io/starr/sonarr"
 	"golift.io/starr/starrtest"
@@ -1664,3 +1669,37 @@ func TestLookupID(t *testing.T) {
 		})
 	}
 }
+
+func TestAddSeriesV4(t *testing.T) {
+	t.Parallel()
+
+	var body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api":
+			_, _ = w.Write([]byte(`{"current":"v3","deprecated":[]}`))
+		case path.Join("/", starr.API, sonarr.APIver, "system", "status"):
+			_, _ = w.Write([]byte(`{"appName":"Sonarr","version":"4.0.0.748","branch":"main"}`))
+		case path.Join("/", starr.API, sonarr.APIver, "series"):
+			input, _ := io.ReadAll(r.Body)
+			body = string(input)
+			_, _ = w.Write([]byte(`{"id":1,"tvdbId":81189}`))
+		}
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	assert.False(t, client.IsV4())
+
+	info, err := client.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, starr.Sonarr, info.App)
+	assert.True(t, client.IsV4())
+
+	input := &sonarr.AddSeriesInput{TvdbID: 81189, LanguageProfileID: 1, QualityProfileID: 2}
+	_, err = client.AddSeries(input)
+	require.NoError(t, err)
+	assert.Equal(t, `{"monitored":false,"qualityProfileId":2,"tvdbId":81189}`+"\n", body)
+	assert.Equal(t, int64(1), input.LanguageProfileID, "the input must not be changed")
+}
@@ -1,6 +1,8 @@
 package sonarr
 
 import (
+	"context"
+	"fmt"
 	"strings"
 
 	"golift.io/starr"
@@ -9,9 +11,19 @@ import (
 // APIver is the Sonarr API version supported by this library.
 const APIver = "v3"
 
+// Errors returned when a method does not work with the Sonarr version found by Detect.
+// These are only returned after Detect or SetAppInfo is called.
+var (
+	// ErrV3Only is returned when a method or field that was removed in Sonarr v4 is used with v4.
+	ErrV3Only = fmt.Errorf("not available in Sonarr v4; this is v3 only")
+	// ErrV4Only is returned when a method that was added in Sonarr v4 is used with v3.
+	ErrV4Only = fmt.Errorf("not available in Sonarr v3; this is v4 only")
+)
+
 // Sonarr contains all the methods to interact with a Sonarr server.
 type Sonarr struct {
 	starr.APIer
+	info *starr.AppInfo // set by Detect or SetAppInfo.
 }
 
 // Filter values are integers. Given names for ease of discovery.
@@ -39,3 +51,51 @@ func New(config *starr.Config) *Sonarr {
 
 	return &Sonarr{APIer: config}
 }
+
+// Detect asks Sonarr for its version, and saves it. Call this before making other requests.
+// Some methods adapt to the saved version; for example, language profiles are not sent to Sonarr v4.
+func (s *Sonarr) Detect(ctx context.Context) (*starr.AppInfo, error) {
+	info, err := starr.Detect(ctx, s.APIer)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is already wrapped.
+	}
+
+	s.info = info
+
+	return info, nil
+}
+
+// SetAppInfo saves the output from starr.Detect, so Sonarr does not have to be asked again.
+// Call this before making other requests.
+func (s *Sonarr) SetAppInfo(info *starr.AppInfo) {
+	s.info = info
+}
+
+// AppInfo returns the app info saved by Detect or SetAppInfo. Returns nil if neither was called.
+func (s *Sonarr) AppInfo() *starr.AppInfo {
+	return s.info
+}
+
+// IsV4 returns true if Detect (or SetAppInfo) found Sonarr v4 or newer.
+// Returns false if the version was never detected.
+func (s *Sonarr) IsV4() bool {
+	return s.info.AtLeast(4) //nolint:gomnd // version 4.
+}
+
+// v3Only returns ErrV3Only if Detect found Sonarr v4 or newer.
+func (s *Sonarr) v3Only(what string) error {
+	if s.IsV4() {
+		return fmt.Errorf("%s: %w (found %s)", what, ErrV3Only, s.info.Version)
+	}
+
+	return nil
+}
+
+// v4Only returns ErrV4Only if Detect found Sonarr v3 or older.
+func (s *Sonarr) v4Only(what string) error {
+	if s.info != nil && !s.IsV4() {
+		return fmt.Errorf("%s: %w (found %s)", what, ErrV4Only, s.info.Version)
+	}
+
+	return nil
+}
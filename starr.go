@@ -54,6 +54,7 @@ type Config struct {
 	Password string       `json:"password" toml:"password" xml:"password" yaml:"password"`
 	Client   *http.Client `json:"-" toml:"-" xml:"-" yaml:"-"`
 	cookie   bool         // this probably doesn't work right.
+	limits   *limits      // optional rate and concurrency limits. See SetRateLimit and SetMaxInFlight.
 }
 
 // New returns a *starr.Config pointer. This pointer is safe to modify
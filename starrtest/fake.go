@@ -0,0 +1,514 @@
+package starrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+/* This file contains a stateful, in-memory fake starr app. The app-specific resources are in fakeapps.go.
+ * The fake stores every item as a JSON object, so it works with every struct in this library.
+ * Use it to test code that makes many calls, without a live app:
+ *
+ *	fake := starrtest.NewFakeSonarr(t)
+ *	client := sonarr.New(fake.Config())
+ *	series, err := client.AddSeries(&sonarr.AddSeriesInput{TvdbID: 1234, QualityProfileID: 1, RootFolderPath: "/tv"})
+ */
+
+// FakeAPIKey is the API key the fake servers accept.
+const FakeAPIKey = "0123456789abcdef0123456789abcdef"
+
+// FakeServer is a stateful, in-memory stand-in for a starr app. It serves the common endpoints this library calls:
+// tags, root folders, quality profiles, the app's media, queue, history, commands and system status.
+// Requests without the right API key get a 401. Invalid items get a 400 with validation failures,
+// shaped like the ones the real apps return. Create one with NewFakeSonarr, NewFakeRadarr, etc.
+type FakeServer struct {
+	*httptest.Server
+	App        starr.App
+	APIKey     string
+	APIVersion string // like v3
+	Version    string // The app version in system/status.
+	t          *testing.T
+	mu         sync.Mutex
+	resources  map[string]*fakeResource
+}
+
+// fakeResource is one endpoint, like tag, and its items.
+type fakeResource struct {
+	name     string
+	required []string          // Fields that must not be empty. Alternatives are separated by a pipe, like path|rootFolderPath.
+	unique   string            // A field that must be unique.
+	exists   string            // The error message for a duplicate unique field.
+	lower    string            // A field that is saved in lower case, like a tag label.
+	filters  map[string]string // Lower-case query parameters that filter lists, and the fields they match.
+	defaults map[string]interface{}
+	paged    bool // Lists are paged, like the queue.
+	readOnly bool // Items can only be added with Seed.
+	noDelete bool
+	command  bool // Items are commands, and complete as soon as they are added.
+	items    map[int64]map[string]interface{}
+	nextID   int64
+}
+
+// newFakeServer starts a fake server with the resources provided. The server is closed when the test finishes.
+func newFakeServer(t *testing.T, app starr.App, apiVersion, version string, resources ...*fakeResource) *FakeServer {
+	t.Helper()
+
+	fake := &FakeServer{
+		App:        app,
+		APIKey:     FakeAPIKey,
+		APIVersion: apiVersion,
+		Version:    version,
+		t:          t,
+		resources:  make(map[string]*fakeResource),
+	}
+
+	for _, resource := range append(fakeCommonResources(), resources...) {
+		resource.items = make(map[int64]map[string]interface{})
+		fake.resources[strings.ToLower(resource.name)] = resource
+	}
+
+	fake.Server = httptest.NewServer(http.HandlerFunc(fake.handle))
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+// Config returns a starr Config for the fake server.
+func (f *FakeServer) Config() *starr.Config {
+	return starr.New(f.APIKey, f.URL, 0)
+}
+
+// Seed adds items to a resource, like queue or history, and returns their IDs.
+// Items may be structs from this library or maps. Items without an ID get the next one.
+// Seeded items are not validated.
+func (f *FakeServer) Seed(resource string, items ...interface{}) []int64 {
+	f.t.Helper()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := f.resource(resource)
+	ids := make([]int64, len(items))
+
+	for idx, item := range items {
+		data, err := json.Marshal(item)
+		require.NoError(f.t, err, "encoding seed item")
+
+		obj, err := decodeObject(data)
+		require.NoError(f.t, err, "seed items must be JSON objects")
+
+		ids[idx] = res.save(obj, fakeID(obj["id"]))
+	}
+
+	return ids
+}
+
+// Items returns a copy of every item in a resource, like series or command, ordered by ID.
+func (f *FakeServer) Items(resource string) []map[string]interface{} {
+	f.t.Helper()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := f.resource(resource)
+	output := make([]map[string]interface{}, 0, len(res.items))
+
+	for _, id := range res.ids() {
+		output = append(output, copyObject(res.items[id]))
+	}
+
+	return output
+}
+
+// resource returns a resource by name, and fails the test if it does not exist.
+func (f *FakeServer) resource(name string) *fakeResource {
+	res := f.resources[strings.ToLower(name)]
+	require.NotNil(f.t, res, "%s fake has no %s resource", f.App, name)
+
+	return res
+}
+
+// handle routes a request to a resource. Paths look like /api/v3/series/12.
+func (f *FakeServer) handle(resp http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("X-Api-Key") != f.APIKey && req.URL.Query().Get("apikey") != f.APIKey {
+		writeFake(resp, http.StatusUnauthorized, json.RawMessage(BodyUnauthorized))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) == 1 && segments[0] == "api":
+		writeFake(resp, http.StatusOK, map[string]interface{}{"current": f.APIVersion, "deprecated": []string{}})
+		return
+	case len(segments) < 3 || segments[0] != "api" || !strings.EqualFold(segments[1], f.APIVersion): //nolint:gomnd
+		writeFake(resp, http.StatusNotFound, json.RawMessage(BodyNotFound))
+		return
+	case len(segments) == 4 && strings.EqualFold(segments[2]+"/"+segments[3], "system/status"): //nolint:gomnd
+		writeFake(resp, http.StatusOK, map[string]interface{}{
+			"appName": f.App.String(), "instanceName": f.App.String(), "version": f.Version, "branch": "main",
+		})
+
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := f.resources[strings.ToLower(segments[2])]
+	if res == nil || len(segments) > 4 { //nolint:gomnd
+		writeFake(resp, http.StatusNotFound, json.RawMessage(BodyNotFound))
+		return
+	}
+
+	var id int64
+
+	if len(segments) == 4 { //nolint:gomnd
+		var err error
+		if id, err = strconv.ParseInt(segments[3], 10, 64); err != nil {
+			writeFake(resp, http.StatusNotFound, json.RawMessage(BodyNotFound))
+			return
+		}
+	}
+
+	status, body := res.serve(req, id)
+	writeFake(resp, status, body)
+}
+
+// serve handles one request for a resource, and returns the status and body to write.
+func (r *fakeResource) serve(req *http.Request, id int64) (int, interface{}) {
+	switch {
+	case req.Method == http.MethodGet && id == 0:
+		return r.list(req)
+	case req.Method == http.MethodGet:
+		if item := r.items[id]; item != nil {
+			return http.StatusOK, item
+		}
+
+		return http.StatusNotFound, json.RawMessage(BodyNotFound)
+	case req.Method == http.MethodDelete && id != 0 && !r.noDelete:
+		if r.items[id] == nil {
+			return http.StatusNotFound, json.RawMessage(BodyNotFound)
+		}
+
+		delete(r.items, id)
+
+		return http.StatusOK, nil
+	case r.readOnly, req.Method != http.MethodPost && req.Method != http.MethodPut:
+		return http.StatusMethodNotAllowed, json.RawMessage(BodyMethodNotAllowed)
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(req.Body); err != nil {
+		return http.StatusBadRequest, map[string]string{"message": err.Error()}
+	}
+
+	item, err := decodeObject(buf.Bytes())
+	if err != nil {
+		return http.StatusBadRequest, map[string]string{"message": err.Error()}
+	}
+
+	if req.Method == http.MethodPost {
+		return r.create(item)
+	}
+
+	if id == 0 {
+		id = fakeID(item["id"])
+	}
+
+	return r.update(id, item)
+}
+
+// list returns every item, or a page of items for paged resources.
+// Query parameters that end in Id, like tvdbId or seriesId, and the resource's filters, filter the items.
+func (r *fakeResource) list(req *http.Request) (int, interface{}) {
+	query := req.URL.Query()
+	records := []map[string]interface{}{}
+
+	for _, id := range r.ids() {
+		if r.matches(r.items[id], query) {
+			records = append(records, r.items[id])
+		}
+	}
+
+	if !r.paged {
+		return http.StatusOK, records
+	}
+
+	if query.Get("sortDirection") == "descending" {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))         //nolint:errcheck // invalid is 0.
+	pageSize, _ := strconv.Atoi(query.Get("pageSize")) //nolint:errcheck // invalid is 0.
+
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize < 1 {
+		pageSize = 10 //nolint:gomnd // the apps' default.
+	}
+
+	start := (page - 1) * pageSize
+	end := start + pageSize
+
+	if start > len(records) {
+		start = len(records)
+	}
+
+	if end > len(records) {
+		end = len(records)
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"page":          page,
+		"pageSize":      pageSize,
+		"sortKey":       query.Get("sortKey"),
+		"sortDirection": query.Get("sortDirection"),
+		"totalRecords":  len(records),
+		"records":       records[start:end],
+	}
+}
+
+// matches returns true if an item matches the ID filters in a query.
+func (r *fakeResource) matches(item map[string]interface{}, query map[string][]string) bool {
+	for key, values := range query {
+		field, ok := r.filters[strings.ToLower(key)]
+		if !ok {
+			field = key
+		}
+
+		if (!ok && !strings.HasSuffix(strings.ToLower(key), "id")) || len(values) == 0 {
+			continue
+		}
+
+		if !strings.EqualFold(fmt.Sprint(fieldFold(item, field)), values[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldFold returns the value of a field in an item, and ignores the case of the field name.
+func fieldFold(item map[string]interface{}, field string) interface{} {
+	for key, value := range item {
+		if strings.EqualFold(key, field) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// create validates and saves a new item. Commands are completed right away.
+func (r *fakeResource) create(item map[string]interface{}) (int, interface{}) {
+	if failures := r.validate(item, 0); len(failures) > 0 {
+		return http.StatusBadRequest, failures
+	}
+
+	if r.command {
+		now := time.Now().UTC()
+		body := copyObject(item)
+		item = map[string]interface{}{
+			"name": item["name"], "commandName": item["name"], "status": starr.CommandCompleted,
+			"result": "successful", "priority": "normal", "trigger": "manual", "body": body,
+			"queued": now, "started": now, "ended": now, "stateChangeTime": now,
+		}
+	}
+
+	id := r.save(item, 0)
+
+	return http.StatusCreated, r.items[id]
+}
+
+// update validates and replaces an existing item.
+func (r *fakeResource) update(id int64, item map[string]interface{}) (int, interface{}) {
+	if r.items[id] == nil {
+		return http.StatusNotFound, json.RawMessage(BodyNotFound)
+	}
+
+	if failures := r.validate(item, id); len(failures) > 0 {
+		return http.StatusBadRequest, failures
+	}
+
+	r.save(item, id)
+
+	return http.StatusAccepted, r.items[id]
+}
+
+// save stores an item with an ID, and returns the ID. An ID of 0 gets the next one.
+func (r *fakeResource) save(item map[string]interface{}, id int64) int64 {
+	if id == 0 {
+		r.nextID++
+		id = r.nextID
+	} else if id > r.nextID {
+		r.nextID = id
+	}
+
+	for key, value := range r.defaults {
+		if _, ok := item[key]; !ok {
+			item[key] = value
+		}
+	}
+
+	if label, ok := item[r.lower].(string); ok {
+		item[r.lower] = strings.ToLower(label)
+	}
+
+	item["id"] = id
+	r.items[id] = item
+
+	return id
+}
+
+// validate returns the validation failures for an item. id is the item being updated, or 0 for a new item.
+func (r *fakeResource) validate(item map[string]interface{}, id int64) []*starr.ValidationFailure {
+	failures := []*starr.ValidationFailure{}
+
+	for _, required := range r.required {
+		alternatives := strings.Split(required, "|")
+		found := false
+
+		for _, field := range alternatives {
+			found = found || !emptyValue(item[field])
+		}
+
+		if !found {
+			failures = append(failures, fakeFailure(alternatives[0], item[alternatives[0]], "'%s' must not be empty."))
+		}
+	}
+
+	if r.unique == "" || emptyValue(item[r.unique]) {
+		return failures
+	}
+
+	for otherID, other := range r.items {
+		if otherID != id && strings.EqualFold(fmt.Sprint(other[r.unique]), fmt.Sprint(item[r.unique])) {
+			failures = append(failures, fakeFailure(r.unique, item[r.unique], r.exists))
+			break
+		}
+	}
+
+	return failures
+}
+
+// ids returns the item IDs in order.
+func (r *fakeResource) ids() []int64 {
+	ids := make([]int64, 0, len(r.items))
+	for id := range r.items {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// fakeFailure returns a validation failure like the ones from the real apps.
+// The message may contain one %s for the field's display name, like 'Tvdb Id'.
+func fakeFailure(field string, value interface{}, message string) *starr.ValidationFailure {
+	display := []rune{}
+
+	for idx, char := range field {
+		if idx == 0 {
+			char = unicode.ToUpper(char)
+		} else if unicode.IsUpper(char) {
+			display = append(display, ' ')
+		}
+
+		display = append(display, char)
+	}
+
+	if strings.Contains(message, "%s") {
+		message = fmt.Sprintf(message, string(display))
+	}
+
+	return &starr.ValidationFailure{
+		PropertyName:   strings.ReplaceAll(string(display), " ", ""),
+		ErrorMessage:   message,
+		Severity:       "error",
+		AttemptedValue: value,
+	}
+}
+
+// emptyValue returns true for missing, empty and zero JSON values.
+func emptyValue(value interface{}) bool {
+	switch val := value.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case json.Number:
+		num, err := val.Float64()
+		return err != nil || num == 0
+	default:
+		return false
+	}
+}
+
+// fakeID returns the ID from a JSON value, or 0.
+func fakeID(value interface{}) int64 {
+	num, ok := value.(json.Number)
+	if !ok {
+		return 0
+	}
+
+	id, _ := num.Int64() //nolint:errcheck // invalid is 0.
+
+	return id
+}
+
+// decodeObject decodes a JSON object, and keeps the numbers as they are.
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	var obj map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("decoding json object: %w", err)
+	}
+
+	if obj == nil {
+		return nil, fmt.Errorf("decoding json object: %w", starr.ErrNilInterface)
+	}
+
+	return obj, nil
+}
+
+// copyObject returns a deep copy of a JSON object.
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(obj)
+	output, _ := decodeObject(data)
+
+	return output
+}
+
+// writeFake writes a JSON response. A nil body writes no body.
+func writeFake(resp http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		resp.WriteHeader(status)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json; charset=utf-8")
+	resp.WriteHeader(status)
+	_ = json.NewEncoder(resp).Encode(body)
+}
@@ -0,0 +1,158 @@
+package starrtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestFakeSonarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t)
+	client := sonarr.New(fake.Config())
+
+	// A bad API key is rejected.
+	_, err := sonarr.New(starr.New("wrong", fake.URL, 0)).GetTags()
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+
+	info, err := client.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, starr.Sonarr, info.App)
+	assert.True(t, client.IsV4())
+
+	tag, err := client.AddTag(&starr.Tag{Label: "Anime"})
+	require.NoError(t, err)
+	assert.Equal(t, "anime", tag.Label, "tag labels are saved in lower case")
+
+	folder, err := client.AddRootFolder(&sonarr.RootFolder{Path: "/tv"})
+	require.NoError(t, err)
+	assert.True(t, folder.Accessible)
+
+	profile, err := client.AddQualityProfile(&sonarr.QualityProfile{Name: "HD"})
+	require.NoError(t, err)
+
+	input := &sonarr.AddSeriesInput{
+		TvdbID: 1234, Title: "Test Show", QualityProfileID: profile.ID, RootFolderPath: folder.Path, Tags: []int{int(tag.ID)},
+	}
+	series, err := client.AddSeries(input)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, series.ID)
+	assert.Equal(t, "Test Show", series.Title)
+
+	// Duplicates and missing fields return validation errors, like the real app.
+	var valErr *starr.ValidationError
+
+	_, err = client.AddSeries(input)
+	require.ErrorAs(t, err, &valErr)
+	assert.Equal(t, "TvdbId", valErr.Failures[0].PropertyName)
+	assert.Equal(t, "This series has already been added", valErr.Failures[0].ErrorMessage)
+
+	_, err = client.AddSeries(&sonarr.AddSeriesInput{TvdbID: 5678})
+	require.ErrorAs(t, err, &valErr)
+	require.Len(t, valErr.Failures, 2)
+	assert.Equal(t, "'Quality Profile Id' must not be empty.", valErr.Failures[0].ErrorMessage)
+	assert.Equal(t, "Path", valErr.Failures[1].PropertyName)
+
+	found, err := client.GetSeries(1234)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+
+	input.ID, input.Title = series.ID, "New Title"
+	series, err = client.UpdateSeries(input, false)
+	require.NoError(t, err)
+	assert.Equal(t, "New Title", series.Title)
+
+	require.NoError(t, client.DeleteSeries(int(series.ID), true, false))
+	_, err = client.GetSeriesByID(series.ID)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Empty(t, fake.Items("series"))
+}
+
+func TestFakeSonarrQueueCommands(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t)
+	client := sonarr.New(fake.Config())
+
+	ids := fake.Seed("queue", &sonarr.QueueRecord{SeriesID: 1, Title: "one"},
+		&sonarr.QueueRecord{SeriesID: 1, Title: "two"}, &sonarr.QueueRecord{SeriesID: 2, Title: "three"})
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+	fake.Seed("history", map[string]interface{}{"seriesId": 1, "sourceTitle": "one", "eventType": "grabbed"})
+
+	// Page through the queue, one record at a time.
+	queue, err := client.GetQueue(0, 1)
+	require.NoError(t, err)
+	require.Len(t, queue.Records, 3)
+	assert.Equal(t, "three", queue.Records[2].Title)
+
+	resp, err := client.Delete(context.Background(), starr.Request{URI: "/api/v3/queue/2"})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Len(t, fake.Items("queue"), 2)
+
+	history, err := client.GetHistory(0, 0)
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	assert.Equal(t, "one", history.Records[0].SourceTitle)
+
+	cmd, err := client.SendCommand(&sonarr.CommandRequest{Name: "SeriesSearch", SeriesID: 1})
+	require.NoError(t, err)
+
+	cmd, err = client.WaitForCommand(cmd, 0)
+	require.NoError(t, err)
+	assert.Equal(t, starr.CommandCompleted, cmd.Status)
+
+	commands := fake.Items("command")
+	require.Len(t, commands, 1)
+	assert.Equal(t, "SeriesSearch", commands[0]["name"])
+}
+
+func TestFakeRadarr(t *testing.T) {
+	t.Parallel()
+
+	client := radarr.New(starrtest.NewFakeRadarr(t).Config())
+
+	movie, err := client.AddMovie(&radarr.AddMovieInput{TmdbID: 603, Title: "Test", QualityProfileID: 1, RootFolderPath: "/m"})
+	require.NoError(t, err)
+	assert.Equal(t, "Test", movie.Title)
+
+	movies, err := client.GetMovie(603)
+	require.NoError(t, err)
+	require.Len(t, movies, 1)
+	assert.Equal(t, movie.ID, movies[0].ID)
+
+	movies, err = client.GetMovie(604)
+	require.NoError(t, err)
+	assert.Empty(t, movies)
+}
+
+func TestFakeLidarrReadarr(t *testing.T) {
+	t.Parallel()
+
+	lidarrClient := lidarr.New(starrtest.NewFakeLidarr(t).Config())
+	_, err := lidarrClient.AddArtist(&lidarr.Artist{
+		ForeignArtistID: "abc", QualityProfileID: 1, MetadataProfileID: 1, RootFolderPath: "/music",
+	})
+	require.NoError(t, err)
+
+	artists, err := lidarrClient.GetArtist("abc")
+	require.NoError(t, err)
+	assert.Len(t, artists, 1)
+
+	readarrClient := readarr.New(starrtest.NewFakeReadarr(t).Config())
+	_, err = readarrClient.AddAuthor(&readarr.AddAuthorInput{ForeignAuthorID: "xyz", RootFolderPath: "/books"})
+
+	var valErr *starr.ValidationError
+	require.True(t, errors.As(err, &valErr))
+	assert.Len(t, valErr.Failures, 2, "quality and metadata profile IDs are missing")
+}
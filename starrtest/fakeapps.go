@@ -0,0 +1,113 @@
+package starrtest
+
+import (
+	"testing"
+
+	"golift.io/starr"
+)
+
+/* This file contains the resources each fake app serves. See fake.go for the server. */
+
+// fakeFreeSpace is the free space reported for every root folder: 1 TiB.
+const fakeFreeSpace = 1 << 40
+
+// NewFakeSonarr returns a fake Sonarr v4 server, with series, queue, history and commands.
+// The server is closed when the test finishes.
+func NewFakeSonarr(t *testing.T) *FakeServer {
+	t.Helper()
+
+	return newFakeServer(t, starr.Sonarr, "v3", "4.0.0.0", &fakeResource{
+		name:     "series",
+		required: []string{"tvdbId", "qualityProfileId", "path|rootFolderPath"},
+		unique:   "tvdbId",
+		exists:   "This series has already been added",
+		defaults: map[string]interface{}{"monitored": true, "seasons": []interface{}{}, "tags": []interface{}{}},
+	})
+}
+
+// NewFakeRadarr returns a fake Radarr v5 server, with movies, queue, history and commands.
+// The server is closed when the test finishes.
+func NewFakeRadarr(t *testing.T) *FakeServer {
+	t.Helper()
+
+	return newFakeServer(t, starr.Radarr, "v3", "5.0.0.0", &fakeResource{
+		name:     "movie",
+		required: []string{"tmdbId", "qualityProfileId", "path|rootFolderPath"},
+		unique:   "tmdbId",
+		exists:   "This movie has already been added",
+		defaults: map[string]interface{}{"monitored": true, "hasFile": false, "tags": []interface{}{}},
+	})
+}
+
+// NewFakeLidarr returns a fake Lidarr v2 server, with artists, albums, queue, history and commands.
+// The server is closed when the test finishes.
+func NewFakeLidarr(t *testing.T) *FakeServer {
+	t.Helper()
+
+	return newFakeServer(t, starr.Lidarr, "v1", "2.0.0.0", &fakeResource{
+		name:     "artist",
+		required: []string{"foreignArtistId", "qualityProfileId", "metadataProfileId", "path|rootFolderPath"},
+		unique:   "foreignArtistId",
+		exists:   "This artist has already been added",
+		filters:  map[string]string{"mbid": "foreignArtistId"},
+		defaults: map[string]interface{}{"monitored": true, "tags": []interface{}{}},
+	}, &fakeResource{
+		name:     "album",
+		required: []string{"foreignAlbumId"},
+		unique:   "foreignAlbumId",
+		exists:   "This album has already been added",
+		defaults: map[string]interface{}{"monitored": true},
+	})
+}
+
+// NewFakeReadarr returns a fake Readarr server, with authors, books, queue, history and commands.
+// The server is closed when the test finishes.
+func NewFakeReadarr(t *testing.T) *FakeServer {
+	t.Helper()
+
+	return newFakeServer(t, starr.Readarr, "v1", "0.3.0.0", &fakeResource{
+		name:     "author",
+		required: []string{"foreignAuthorId", "qualityProfileId", "metadataProfileId", "path|rootFolderPath"},
+		unique:   "foreignAuthorId",
+		exists:   "This author has already been added",
+		defaults: map[string]interface{}{"monitored": true, "tags": []interface{}{}},
+	}, &fakeResource{
+		name:     "book",
+		required: []string{"foreignBookId"},
+		unique:   "foreignBookId",
+		exists:   "This book has already been added",
+		filters:  map[string]string{"titleslug": "titleSlug"},
+		defaults: map[string]interface{}{"monitored": true},
+	})
+}
+
+// fakeCommonResources returns the resources every fake app serves.
+func fakeCommonResources() []*fakeResource {
+	return []*fakeResource{
+		{
+			name:     "tag",
+			required: []string{"label"},
+			unique:   "label",
+			exists:   "'%s' must be unique.",
+			lower:    "label",
+		},
+		{
+			name:     "rootFolder",
+			required: []string{"path"},
+			unique:   "path",
+			exists:   "Path is already configured as a root folder",
+			defaults: map[string]interface{}{
+				"accessible": true, "freeSpace": fakeFreeSpace, "unmappedFolders": []interface{}{},
+			},
+		},
+		{
+			name:     "qualityProfile",
+			required: []string{"name"},
+			unique:   "name",
+			exists:   "'%s' must be unique.",
+		},
+		{name: "queue", paged: true, readOnly: true},
+		{name: "history", paged: true, readOnly: true, noDelete: true},
+		{name: "command", required: []string{"name"}, command: true},
+	}
+}
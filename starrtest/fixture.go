@@ -0,0 +1,311 @@
+package starrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr/debuglog"
+)
+
+/* This file contains the code to record real requests into golden files, and replay them from a test server.
+ * Record a scenario against a real app once:
+ *
+ *	recorder := starrtest.NewRecorder("add series then search", nil)
+ *	config := starr.New(apiKey, appURL, 0)
+ *	config.Client.Transport = recorder
+ *	// ... make the requests with sonarr.New(config) ...
+ *	err := recorder.Save("testdata/add_series_search.json")
+ *
+ * Then replay it offline in a test:
+ *
+ *	scenario, err := starrtest.LoadScenario("testdata/add_series_search.json")
+ *	server := scenario.Server(t, starrtest.ReplayInOrder)
+ *	defer server.Close()
+ *	client := sonarr.New(starr.New("fakekey", server.URL, 0))
+ */
+
+// ReplayMode controls how a replay server picks the interaction for a request.
+type ReplayMode int
+
+const (
+	// ReplayInOrder serves the interactions in the order they were recorded.
+	// Every request must match the next interaction's method, path and body.
+	ReplayInOrder ReplayMode = iota
+	// ReplayMatch serves the first unused interaction with the request's method and path.
+	// When every match has been used, the last match is served again; this allows polling, like for commands.
+	ReplayMatch
+)
+
+// Interaction is one recorded request and its response.
+// Bodies are stored as JSON. Other bodies, like login forms, are stored as JSON strings.
+type Interaction struct {
+	Method      string          `json:"method"`
+	Path        string          `json:"path"` // Includes the query string.
+	Request     json.RawMessage `json:"request,omitempty"`
+	Status      int             `json:"status"`
+	ContentType string          `json:"contentType,omitempty"`
+	Response    json.RawMessage `json:"response,omitempty"`
+}
+
+// Scenario is a list of interactions, like the requests to add a series and search for it.
+// This is the format of a golden file.
+type Scenario struct {
+	Name         string         `json:"name"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records every request and response into a Scenario.
+// Secrets are scrubbed with the debuglog redaction defaults before they are stored.
+// Create this with NewRecorder.
+type Recorder struct {
+	next     http.RoundTripper
+	redact   *debuglog.Redactor
+	mu       sync.Mutex
+	scenario Scenario
+}
+
+// NewRecorder returns a Recorder. Pass nil for next to use http.DefaultTransport.
+func NewRecorder(name string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Recorder{
+		next:     next,
+		redact:   debuglog.NewRedactor(debuglog.Config{}),
+		scenario: Scenario{Name: name},
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface, and records the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent []byte
+
+	if req.Body != nil {
+		var err error
+		if sent, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(sent))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return resp, err //nolint:wrapcheck // this is a pass-through.
+	}
+
+	rcvd, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(rcvd))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.scenario.Interactions = append(r.scenario.Interactions, &Interaction{
+		Method:      req.Method,
+		Path:        requestPath(r.redact, req),
+		Request:     rawBody(r.redact, sent, req.Header.Get("Content-Type")),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Response:    rawBody(r.redact, rcvd, resp.Header.Get("Content-Type")),
+	})
+
+	return resp, nil
+}
+
+// Scenario returns a copy of the recorded scenario.
+func (r *Recorder) Scenario() *Scenario {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &Scenario{
+		Name:         r.scenario.Name,
+		Interactions: append([]*Interaction{}, r.scenario.Interactions...),
+	}
+}
+
+// Save writes the recorded scenario to a golden file.
+func (r *Recorder) Save(filePath string) error {
+	return r.Scenario().Save(filePath)
+}
+
+// LoadScenario reads a scenario from a golden file.
+func LoadScenario(filePath string) (*Scenario, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading scenario: %w", err)
+	}
+
+	var scenario Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("decoding scenario %s: %w", filePath, err)
+	}
+
+	return &scenario, nil
+}
+
+// Save writes the scenario to a golden file.
+func (s *Scenario) Save(filePath string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding scenario: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, append(data, '\n'), 0o600); err != nil { //nolint:gomnd
+		return fmt.Errorf("writing scenario: %w", err)
+	}
+
+	return nil
+}
+
+// Server returns a test server that replays the scenario. Requests that do not match an interaction fail the test,
+// and get a 500 response. The test also fails if any interaction was not used by the time it finishes.
+// Close the server when the test is done.
+func (s *Scenario) Server(t *testing.T, mode ReplayMode) *httptest.Server {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		used   = make([]bool, len(s.Interactions))
+		redact = debuglog.NewRedactor(debuglog.Config{})
+	)
+
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for idx, wasUsed := range used {
+			assert.True(t, wasUsed, "%s: interaction %d (%s %s) was not replayed",
+				s.Name, idx, s.Interactions[idx].Method, s.Interactions[idx].Path)
+		}
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		path := requestPath(redact, req)
+
+		idx := s.find(mode, used, req.Method, path)
+		if idx < 0 {
+			assert.Fail(t, "unexpected request", "%s: no interaction for %s %s", s.Name, req.Method, path)
+			writer.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		used[idx] = true
+		interaction := s.Interactions[idx]
+
+		if mode == ReplayInOrder {
+			assert.Equal(t, compact(interaction.Request),
+				compact(rawBody(redact, body, req.Header.Get("Content-Type"))),
+				"%s: request body for interaction %d (%s %s) does not match", s.Name, idx, req.Method, path)
+		}
+
+		interaction.write(writer)
+	}))
+}
+
+// find returns the index of the interaction to replay for a request, or -1 if there is none.
+func (s *Scenario) find(mode ReplayMode, used []bool, method, path string) int {
+	if mode == ReplayInOrder {
+		for idx, interaction := range s.Interactions {
+			if !used[idx] {
+				if interaction.Method == method && interaction.Path == path {
+					return idx
+				}
+
+				return -1
+			}
+		}
+
+		return -1
+	}
+
+	last := -1
+
+	for idx, interaction := range s.Interactions {
+		if interaction.Method != method || interaction.Path != path {
+			continue
+		}
+
+		if !used[idx] {
+			return idx
+		}
+
+		last = idx
+	}
+
+	return last
+}
+
+// write sends the recorded response.
+func (i *Interaction) write(writer http.ResponseWriter) {
+	body := []byte(compact(i.Response))
+
+	// Bodies that are not JSON were stored as JSON strings.
+	var text string
+	if !strings.Contains(i.ContentType, "json") && json.Unmarshal(body, &text) == nil {
+		body = []byte(text)
+	}
+
+	if i.ContentType != "" {
+		writer.Header().Set("Content-Type", i.ContentType)
+	}
+
+	writer.WriteHeader(i.Status)
+	_, _ = writer.Write(body)
+}
+
+// requestPath returns the request path and query string, with secrets masked.
+func requestPath(redact *debuglog.Redactor, req *http.Request) string {
+	return redact.URL(&url.URL{Path: req.URL.Path, RawQuery: req.URL.RawQuery})
+}
+
+// rawBody returns a body with secrets masked, as JSON. Bodies that are not JSON become JSON strings.
+func rawBody(redact *debuglog.Redactor, body []byte, contentType string) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+
+	if strings.Contains(contentType, "form") {
+		body = []byte(redact.Form(string(body)))
+	} else if body = redact.JSON(body); json.Valid(body) {
+		return body
+	}
+
+	text, _ := json.Marshal(string(body))
+
+	return text
+}
+
+// compact removes the indentation from a stored JSON body, so bodies compare equal after a golden file is saved.
+func compact(body json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return string(body)
+	}
+
+	return buf.String()
+}
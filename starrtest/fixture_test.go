@@ -0,0 +1,138 @@
+package starrtest_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+const testAPIKey = "abcdef0123456789abcdef0123456789"
+
+// realServer stands in for a real app while recording.
+func realServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var polls int32
+
+	return httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+		switch req.Method + " " + req.URL.Path {
+		case "POST /api/v3/series":
+			_, _ = resp.Write([]byte(`{"id":5,"title":"Test Show","tvdbId":1234}`))
+		case "POST /api/v3/downloadClient":
+			body, _ := io.ReadAll(req.Body)
+			_, _ = resp.Write(body)
+		case "POST /api/v3/command":
+			_, _ = resp.Write([]byte(`{"id":9,"name":"SeriesSearch","status":"queued"}`))
+		case "GET /api/v3/command/9":
+			if atomic.AddInt32(&polls, 1) == 1 {
+				_, _ = resp.Write([]byte(`{"id":9,"name":"SeriesSearch","status":"started"}`))
+			} else {
+				_, _ = resp.Write([]byte(`{"id":9,"name":"SeriesSearch","status":"completed"}`))
+			}
+		default:
+			resp.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// addThenSearch is the multi-call flow under test. It returns the last command status.
+func addThenSearch(t *testing.T, client *sonarr.Sonarr) string {
+	t.Helper()
+
+	series, err := client.AddSeries(&sonarr.AddSeriesInput{TvdbID: 1234, QualityProfileID: 1})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, series.ID)
+
+	_, err = client.AddDownloadClient(&sonarr.DownloadClientInput{
+		Name:   "SABnzbd",
+		Fields: []*starr.FieldInput{{Name: "apiKey", Value: "secret-key"}},
+	})
+	require.NoError(t, err)
+
+	cmd, err := client.SendCommand(&sonarr.CommandRequest{Name: "SeriesSearch", SeriesID: series.ID})
+	require.NoError(t, err)
+
+	status, err := client.GetCommandStatus(cmd.ID)
+	require.NoError(t, err)
+	assert.Equal(t, starr.CommandStarted, status.Status)
+
+	status, err = client.GetCommandStatus(cmd.ID)
+	require.NoError(t, err)
+
+	return status.Status
+}
+
+func TestRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	app := realServer(t)
+	defer app.Close()
+
+	recorder := starrtest.NewRecorder("add series then search", nil)
+	config := starr.New(testAPIKey, app.URL, 0)
+	config.Client.Transport = recorder
+	assert.Equal(t, starr.CommandCompleted, addThenSearch(t, sonarr.New(config)))
+
+	golden := filepath.Join(t.TempDir(), "add_series_search.json")
+	require.NoError(t, recorder.Save(golden))
+
+	data, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-key", "secrets must be scrubbed")
+	assert.NotContains(t, string(data), testAPIKey, "the API key must not be recorded")
+	assert.True(t, json.Valid(data))
+
+	scenario, err := starrtest.LoadScenario(golden)
+	require.NoError(t, err)
+	require.Len(t, scenario.Interactions, 5)
+	assert.Equal(t, "/api/v3/command/9", scenario.Interactions[4].Path)
+
+	// Replay the whole flow offline, in order.
+	server := scenario.Server(t, starrtest.ReplayInOrder)
+	defer server.Close()
+
+	assert.Equal(t, starr.CommandCompleted,
+		addThenSearch(t, sonarr.New(starr.New("fakekey", server.URL, 0))))
+}
+
+func TestReplayMatch(t *testing.T) {
+	t.Parallel()
+
+	scenario := &starrtest.Scenario{Name: "poll", Interactions: []*starrtest.Interaction{
+		{Method: http.MethodGet, Path: "/api/v3/command/9", Status: http.StatusOK,
+			ContentType: "application/json", Response: json.RawMessage(`{"id":9,"status":"started"}`)},
+		{Method: http.MethodGet, Path: "/api/v3/command/9", Status: http.StatusOK,
+			ContentType: "application/json", Response: json.RawMessage(`{"id":9,"status":"completed"}`)},
+		{Method: http.MethodGet, Path: "/api/v3/series/5", Status: http.StatusOK,
+			ContentType: "application/json", Response: json.RawMessage(`{"id":5,"title":"Test Show"}`)},
+	}}
+
+	server := scenario.Server(t, starrtest.ReplayMatch)
+	defer server.Close()
+
+	client := sonarr.New(starr.New("fakekey", server.URL, 0))
+
+	// Requests may arrive in any order, and the last match repeats.
+	series, err := client.GetSeriesByID(5)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Show", series.Title)
+
+	for _, expect := range []string{starr.CommandStarted, starr.CommandCompleted, starr.CommandCompleted} {
+		status, err := client.GetCommandStatus(9)
+		require.NoError(t, err)
+		assert.Equal(t, expect, status.Status)
+	}
+}
@@ -0,0 +1,244 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/* This file contains two metrics hooks.
+ * Metrics keeps Prometheus-style counters and histograms in memory, and serves them in the Prometheus text format.
+ * Collectors sends the same data to counters and histograms you own, like the ones in the Prometheus client library.
+ */
+
+// DefaultBuckets are the histogram buckets (in seconds) used by NewMetrics when none are provided.
+//
+//nolint:gochecknoglobals
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics is a Hook that keeps request counters and duration histograms in memory.
+// It serves them in the Prometheus text format, so they can be scraped without an external collector library.
+// Create this with NewMetrics.
+type Metrics struct {
+	buckets   []float64
+	mu        sync.Mutex
+	requests  map[requestLabels]uint64
+	retries   map[endpointLabels]uint64
+	durations map[endpointLabels]*histogram
+}
+
+type endpointLabels struct {
+	app, method, endpoint string
+}
+
+type requestLabels struct {
+	endpointLabels
+	status, class string
+}
+
+type histogram struct {
+	counts []uint64 // one per bucket.
+	count  uint64
+	sum    float64
+}
+
+// Metrics must satisfy the Hook interface.
+var _ Hook = (*Metrics)(nil)
+
+// NewMetrics returns an empty Metrics hook. Pass nil for buckets to use DefaultBuckets.
+func NewMetrics(buckets []float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	buckets = append([]float64{}, buckets...)
+	sort.Float64s(buckets)
+
+	return &Metrics{
+		buckets:   buckets,
+		requests:  make(map[requestLabels]uint64),
+		retries:   make(map[endpointLabels]uint64),
+		durations: make(map[endpointLabels]*histogram),
+	}
+}
+
+// Start satisfies the Hook interface, and does nothing.
+func (m *Metrics) Start(ctx context.Context, _ *Event) context.Context {
+	return ctx
+}
+
+// End satisfies the Hook interface, and records the request.
+func (m *Metrics) End(_ context.Context, event *Event) {
+	labels := endpointLabels{app: event.App, method: event.Method, endpoint: event.Endpoint}
+	seconds := event.Duration.Seconds()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[requestLabels{endpointLabels: labels, status: strconv.Itoa(event.Status), class: event.ErrorClass}]++
+
+	if event.Retries > 0 {
+		m.retries[labels]++
+	}
+
+	hist := m.durations[labels]
+	if hist == nil {
+		hist = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.durations[labels] = hist
+	}
+
+	hist.count++
+	hist.sum += seconds
+
+	for idx, bucket := range m.buckets {
+		if seconds <= bucket {
+			hist.counts[idx]++
+		}
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format. Use this as a /metrics handler.
+func (m *Metrics) ServeHTTP(resp http.ResponseWriter, _ *http.Request) {
+	resp.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = m.WriteTo(resp)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	m.mu.Lock()
+	m.writeRequests(&buf)
+	m.writeRetries(&buf)
+	m.writeDurations(&buf)
+	m.mu.Unlock()
+
+	size, err := buf.WriteTo(w)
+	if err != nil {
+		return size, fmt.Errorf("writing metrics: %w", err)
+	}
+
+	return size, nil
+}
+
+func (m *Metrics) writeRequests(buf *bytes.Buffer) {
+	lines := make([]string, 0, len(m.requests))
+	for labels, count := range m.requests {
+		lines = append(lines, fmt.Sprintf("starr_requests_total{%s,status=%s,error=%s} %d",
+			labels.endpointLabels, quote(labels.status), quote(labels.class), count))
+	}
+
+	sort.Strings(lines)
+	writeMetric(buf, "starr_requests_total", "counter", "Requests made to starr apps.", lines)
+}
+
+func (m *Metrics) writeRetries(buf *bytes.Buffer) {
+	lines := make([]string, 0, len(m.retries))
+	for labels, count := range m.retries {
+		lines = append(lines, fmt.Sprintf("starr_request_retries_total{%s} %d", labels, count))
+	}
+
+	sort.Strings(lines)
+	writeMetric(buf, "starr_request_retries_total", "counter", "Requests to starr apps that were retries.", lines)
+}
+
+func (m *Metrics) writeDurations(buf *bytes.Buffer) {
+	lines := make([]string, 0, len(m.durations)*(len(m.buckets)+3)) //nolint:gomnd // +Inf, sum and count.
+
+	keys := make([]endpointLabels, 0, len(m.durations))
+	for labels := range m.durations {
+		keys = append(keys, labels)
+	}
+
+	// Sort the series, but keep each series' buckets in order.
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	for _, labels := range keys {
+		hist := m.durations[labels]
+
+		for idx, bucket := range m.buckets {
+			lines = append(lines, fmt.Sprintf("starr_request_duration_seconds_bucket{%s,le=%s} %d",
+				labels, quote(strconv.FormatFloat(bucket, 'g', -1, 64)), hist.counts[idx]))
+		}
+
+		lines = append(lines,
+			fmt.Sprintf(`starr_request_duration_seconds_bucket{%s,le="+Inf"} %d`, labels, hist.count),
+			fmt.Sprintf("starr_request_duration_seconds_sum{%s} %s", labels, strconv.FormatFloat(hist.sum, 'g', -1, 64)),
+			fmt.Sprintf("starr_request_duration_seconds_count{%s} %d", labels, hist.count))
+	}
+
+	writeMetric(buf, "starr_request_duration_seconds", "histogram",
+		"Time until starr apps returned response headers.", lines)
+}
+
+// writeMetric writes the help and type lines, and the metric lines. Nothing is written if there are no lines.
+func writeMetric(buf *bytes.Buffer, name, kind, help string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n%s\n", name, help, name, kind, strings.Join(lines, "\n"))
+}
+
+// String formats the labels for the Prometheus text format.
+func (e endpointLabels) String() string {
+	return "app=" + quote(e.app) + ",method=" + quote(e.method) + ",endpoint=" + quote(e.endpoint)
+}
+
+// quote escapes a label value for the Prometheus text format.
+func quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// Counter is satisfied by a prometheus.Counter, and most other counters.
+type Counter interface {
+	Inc()
+}
+
+// Observer is satisfied by a prometheus.Observer (like a Histogram), and most other histograms.
+type Observer interface {
+	Observe(value float64)
+}
+
+// Collectors is a Hook that sends request metrics to counters and histograms you provide.
+// Each function is like the WithLabelValues method on a Prometheus vector. Nil functions are skipped.
+// Example, with a prometheus.CounterVec that has 5 labels:
+//
+//	telemetry.Collectors{Requests: func(labels ...string) telemetry.Counter { return vec.WithLabelValues(labels...) }}
+type Collectors struct {
+	// Requests is called with app, method, endpoint, status and error class for every request.
+	Requests func(labels ...string) Counter
+	// Retries is called with app, method and endpoint for every request that is a retry.
+	Retries func(labels ...string) Counter
+	// Durations is called with app, method and endpoint for every request, and observes the seconds.
+	Durations func(labels ...string) Observer
+}
+
+// Collectors must satisfy the Hook interface.
+var _ Hook = (*Collectors)(nil)
+
+// Start satisfies the Hook interface, and does nothing.
+func (c *Collectors) Start(ctx context.Context, _ *Event) context.Context {
+	return ctx
+}
+
+// End satisfies the Hook interface, and sends the request metrics to the collectors.
+func (c *Collectors) End(_ context.Context, event *Event) {
+	if c.Requests != nil {
+		c.Requests(event.App, event.Method, event.Endpoint, strconv.Itoa(event.Status), event.ErrorClass).Inc()
+	}
+
+	if c.Retries != nil && event.Retries > 0 {
+		c.Retries(event.App, event.Method, event.Endpoint).Inc()
+	}
+
+	if c.Durations != nil {
+		c.Durations(event.App, event.Method, event.Endpoint).Observe(event.Duration.Seconds())
+	}
+}
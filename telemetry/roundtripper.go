@@ -0,0 +1,192 @@
+// Package telemetry provides a RoundTripper you can put into an HTTP client Transport
+// to emit per-request spans and metrics from requests made with that client.
+// Use it with a starr.Config like this:
+//
+//	metrics := telemetry.NewMetrics(nil)
+//	config := starr.New(apiKey, appURL, 0)
+//	config.Client.Transport = telemetry.NewRoundTripper(telemetry.Config{App: "Sonarr", Hooks: []telemetry.Hook{metrics}}, nil)
+//	http.Handle("/metrics", metrics)
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"golift.io/starr/debuglog"
+)
+
+// Error classes for Event.ErrorClass. A successful request has an empty error class.
+const (
+	ClassTimeout     = "timeout"      // The request timed out.
+	ClassCanceled    = "canceled"     // The request context was cancelled.
+	ClassNetwork     = "network"      // The request failed without a response.
+	ClassAuth        = "auth"         // The app returned 401 or 403. The API key is probably wrong.
+	ClassNotFound    = "not_found"    // The app returned 404.
+	ClassClientError = "client_error" // The app returned another 4xx status.
+	ClassServerError = "server_error" // The app returned a 5xx status.
+)
+
+// Config is the input data for the RoundTripper.
+type Config struct {
+	App   string // App name, like Sonarr. Added to every event.
+	Hooks []Hook // These receive every event, in order.
+}
+
+// Event contains the data about one request.
+type Event struct {
+	App        string        // From Config.
+	Method     string        // HTTP method, like GET.
+	Endpoint   string        // Endpoint template, like /api/v3/movie/{id}. See Template.
+	URL        string        // Request URL. Secrets in the query string are masked, and user info is removed.
+	Start      time.Time     // When the request was sent.
+	Duration   time.Duration // Time until the response headers arrived, or the request failed. Zero in Start.
+	Status     int           // HTTP status code. 0 if there was no response, or in Start.
+	ErrorClass string        // One of the Class constants. Empty for 2xx and 3xx responses, and in Start.
+	Err        error         // The error from the transport, if any.
+	Retries    int           // Earlier attempts at this request. See WithRetry.
+}
+
+// Hook receives events from the RoundTripper. Start is called before the request is sent, and End after.
+// Start returns the context used for the request; tracing hooks can use it to carry a span.
+// End receives the context that Start returned, and the same event, with the results filled in.
+type Hook interface {
+	Start(ctx context.Context, event *Event) context.Context
+	End(ctx context.Context, event *Event)
+}
+
+// HookFunc turns a function into a Hook that only uses End events.
+type HookFunc func(ctx context.Context, event *Event)
+
+// Start satisfies the Hook interface, and does nothing.
+func (f HookFunc) Start(ctx context.Context, _ *Event) context.Context { return ctx }
+
+// End satisfies the Hook interface, and calls the function.
+func (f HookFunc) End(ctx context.Context, event *Event) { f(ctx, event) }
+
+// RoundTripper emits an event to each hook for every request.
+type RoundTripper struct {
+	next   http.RoundTripper // The next Transport to call after starting the event.
+	config *Config
+	redact *debuglog.Redactor // Masks secrets, like an apikey parameter, in Event.URL.
+}
+
+type retryKey struct{}
+
+// WithRetry returns a context that marks a request as a retry. attempt is the number of earlier attempts.
+// starr does not retry requests; use this in your own retry loop or middleware, so events report retries.
+func WithRetry(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryKey{}, attempt)
+}
+
+// NewRoundTripper returns a round tripper that emits an event to the hooks for every request.
+func NewRoundTripper(config Config, next http.RoundTripper) *RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &RoundTripper{
+		next:   next,
+		config: &config,
+		redact: debuglog.NewRedactor(debuglog.Config{}),
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	event := &Event{
+		App:      rt.config.App,
+		Method:   req.Method,
+		Endpoint: Template(req.URL.Path),
+		URL:      rt.eventURL(req.URL),
+		Start:    time.Now(),
+	}
+
+	if retries, ok := ctx.Value(retryKey{}).(int); ok {
+		event.Retries = retries
+	}
+
+	contexts := make([]context.Context, len(rt.config.Hooks))
+	for idx, hook := range rt.config.Hooks {
+		ctx = hook.Start(ctx, event)
+		contexts[idx] = ctx
+	}
+
+	resp, err := rt.next.RoundTrip(req.WithContext(ctx))
+
+	event.Duration = time.Since(event.Start)
+	event.Err = err
+
+	if resp != nil {
+		event.Status = resp.StatusCode
+	}
+
+	event.ErrorClass = ErrorClass(event.Status, err)
+
+	for idx := len(rt.config.Hooks) - 1; idx >= 0; idx-- {
+		rt.config.Hooks[idx].End(contexts[idx], event)
+	}
+
+	return resp, err //nolint:wrapcheck // this is a pass-through.
+}
+
+// eventURL returns the URL for an event, with query string secrets masked and user info removed.
+// Tracing hooks may export the URL, so it must not contain credentials.
+func (rt *RoundTripper) eventURL(input *url.URL) string {
+	output := *input
+	output.User = nil
+
+	return rt.redact.URL(&output)
+}
+
+// ErrorClass returns one of the Class constants for a status code and transport error.
+// Returns an empty string for 2xx and 3xx responses.
+func ErrorClass(status int, err error) string {
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ClassCanceled
+	case err != nil:
+		return ClassNetwork
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ClassAuth
+	case status == http.StatusNotFound:
+		return ClassNotFound
+	case status >= http.StatusInternalServerError:
+		return ClassServerError
+	case status >= http.StatusBadRequest:
+		return ClassClientError
+	default:
+		return ""
+	}
+}
+
+// idSegment matches path segments that are IDs: numbers, GUIDs, and long hex strings like hashes.
+var idSegment = regexp.MustCompile(`^(\d+|[0-9a-fA-F]{8}(-[0-9a-fA-F]{4}){3}-[0-9a-fA-F]{12}|[0-9a-fA-F]{32,})$`)
+
+// Template turns a request path into an endpoint template, so metrics do not have one series per item.
+// IDs become {id}, and anything before /api (like a URL base) is removed.
+// An input of /sonarr/api/v3/series/12 returns /api/v3/series/{id}.
+func Template(uriPath string) string {
+	if idx := strings.Index(uriPath, "/api/"); idx > 0 {
+		uriPath = uriPath[idx:]
+	}
+
+	segments := strings.Split(uriPath, "/")
+	for idx, segment := range segments {
+		if idSegment.MatchString(segment) {
+			segments[idx] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,140 @@
+package telemetry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/debuglog"
+	"golift.io/starr/telemetry"
+)
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"/api/v3/movie/12":                                     "/api/v3/movie/{id}",
+		"/sonarr/api/v3/series/12":                             "/api/v3/series/{id}",
+		"/api/v1/book/lookup":                                  "/api/v1/book/lookup",
+		"/api/v3/queue/5/d41d8cd98f00b204e9800998ecf8427e":     "/api/v3/queue/{id}/{id}",
+		"/api/v1/indexer/1b671a64-40d5-491e-99b0-da01ff1f3341": "/api/v1/indexer/{id}",
+		"/login": "/login",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, telemetry.Template(input), input)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", telemetry.ErrorClass(http.StatusOK, nil))
+	assert.Equal(t, telemetry.ClassAuth, telemetry.ErrorClass(http.StatusUnauthorized, nil))
+	assert.Equal(t, telemetry.ClassNotFound, telemetry.ErrorClass(http.StatusNotFound, nil))
+	assert.Equal(t, telemetry.ClassClientError, telemetry.ErrorClass(http.StatusBadRequest, nil))
+	assert.Equal(t, telemetry.ClassServerError, telemetry.ErrorClass(http.StatusBadGateway, nil))
+	assert.Equal(t, telemetry.ClassTimeout, telemetry.ErrorClass(0, context.DeadlineExceeded))
+	assert.Equal(t, telemetry.ClassCanceled, telemetry.ErrorClass(0, context.Canceled))
+	assert.Equal(t, telemetry.ClassNetwork, telemetry.ErrorClass(0, http.ErrServerClosed))
+}
+
+type testCounter struct{}
+
+func (c *testCounter) Inc() {}
+
+func TestRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/lookup") {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var (
+		events  []*telemetry.Event
+		labels  [][]string
+		metrics = telemetry.NewMetrics([]float64{1, 0.5})
+	)
+
+	hooks := []telemetry.Hook{
+		metrics,
+		telemetry.HookFunc(func(_ context.Context, event *telemetry.Event) { events = append(events, event) }),
+		&telemetry.Collectors{Requests: func(values ...string) telemetry.Counter {
+			labels = append(labels, values)
+			return &testCounter{}
+		}},
+	}
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client.Transport = telemetry.NewRoundTripper(telemetry.Config{App: "Radarr", Hooks: hooks}, nil)
+
+	var output interface{}
+
+	require.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "v3/movie/12"}, &output))
+	require.Error(t, config.GetInto(telemetry.WithRetry(context.Background(), 1), starr.Request{URI: "v3/movie/lookup"}, &output))
+
+	require.Len(t, events, 2)
+	assert.Equal(t, server.URL+"/api/v3/movie/12", events[0].URL)
+	assert.Equal(t, "Radarr", events[0].App)
+	assert.Equal(t, "/api/v3/movie/{id}", events[0].Endpoint)
+	assert.Equal(t, http.StatusOK, events[0].Status)
+	assert.Equal(t, "", events[0].ErrorClass)
+	assert.Equal(t, 0, events[0].Retries)
+	assert.Equal(t, telemetry.ClassNotFound, events[1].ErrorClass)
+	assert.Equal(t, "/api/v3/movie/lookup", events[1].Endpoint)
+	assert.Equal(t, 1, events[1].Retries)
+	assert.Equal(t, [][]string{
+		{"Radarr", "GET", "/api/v3/movie/{id}", "200", ""},
+		{"Radarr", "GET", "/api/v3/movie/lookup", "404", "not_found"},
+	}, labels)
+
+	var buf strings.Builder
+
+	_, err := metrics.WriteTo(&buf)
+	require.NoError(t, err)
+
+	text := buf.String()
+	assert.Contains(t, text, "# TYPE starr_requests_total counter\n")
+	assert.Contains(t, text,
+		`starr_requests_total{app="Radarr",method="GET",endpoint="/api/v3/movie/{id}",status="200",error=""} 1`)
+	assert.Contains(t, text, `starr_request_retries_total{app="Radarr",method="GET",endpoint="/api/v3/movie/lookup"} 1`)
+	assert.Contains(t, text,
+		`starr_request_duration_seconds_bucket{app="Radarr",method="GET",endpoint="/api/v3/movie/{id}",le="0.5"} 1`)
+	assert.Contains(t, text,
+		`starr_request_duration_seconds_count{app="Radarr",method="GET",endpoint="/api/v3/movie/{id}"} 1`)
+}
+
+func TestRoundTripperRedactsURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var urls []string
+
+	hook := telemetry.HookFunc(func(_ context.Context, event *telemetry.Event) { urls = append(urls, event.URL) })
+	config := starr.New("mockAPIkey", strings.Replace(server.URL, "://", "://user:hunter2@", 1), 0)
+	config.Client.Transport = telemetry.NewRoundTripper(telemetry.Config{Hooks: []telemetry.Hook{hook}}, nil)
+
+	var output interface{}
+
+	req := starr.Request{URI: "v3/movie", Query: url.Values{"apikey": []string{"secret-key"}, "tmdbId": []string{"12"}}}
+	require.NoError(t, config.GetInto(context.Background(), req, &output))
+	require.Len(t, urls, 1)
+	assert.NotContains(t, urls[0], "secret-key")
+	assert.NotContains(t, urls[0], "hunter2")
+	assert.Contains(t, urls[0], "apikey="+debuglog.Mask+"&tmdbId=12")
+}